@@ -0,0 +1,18 @@
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// SentinelAlertRuleReadCacheEnabled returns whether or not Sentinel Alert Rules should be
+// read from a per-workspace cache.
+//
+// When enabled, the first read of a Sentinel Alert Rule lists every Alert Rule within the
+// Log Analytics Workspace and subsequent reads are served from that list - which reduces the
+// number of API calls made when refreshing workspaces containing a large number of rules.
+//
+// It's possible to opt into this by setting `ARM_PROVIDER_SENTINEL_ALERT_RULE_CACHE` to `true`.
+func SentinelAlertRuleReadCacheEnabled() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_SENTINEL_ALERT_RULE_CACHE"), "true")
+}
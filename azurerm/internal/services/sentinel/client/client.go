@@ -8,7 +8,11 @@ import (
 type Client struct {
 	AlertRulesClient         *securityinsight.AlertRulesClient
 	AlertRuleTemplatesClient *securityinsight.AlertRuleTemplatesClient
+	AutomationRulesClient    *securityinsight.AutomationRulesClient
 	DataConnectorsClient     *securityinsight.DataConnectorsClient
+	IncidentRelationsClient  *securityinsight.IncidentRelationsClient
+	IncidentsClient          *securityinsight.IncidentsClient
+	WatchlistsClient         *securityinsight.WatchlistsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +22,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	alertRuleTemplatesClient := securityinsight.NewAlertRuleTemplatesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&alertRuleTemplatesClient.Client, o.ResourceManagerAuthorizer)
 
+	automationRulesClient := securityinsight.NewAutomationRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&automationRulesClient.Client, o.ResourceManagerAuthorizer)
+
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	incidentRelationsClient := securityinsight.NewIncidentRelationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&incidentRelationsClient.Client, o.ResourceManagerAuthorizer)
+
+	incidentsClient := securityinsight.NewIncidentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&incidentsClient.Client, o.ResourceManagerAuthorizer)
+
+	watchlistsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&watchlistsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AlertRulesClient:         &alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
+		AutomationRulesClient:    &automationRulesClient,
 		DataConnectorsClient:     &dataConnectorsClient,
+		IncidentRelationsClient:  &incidentRelationsClient,
+		IncidentsClient:          &incidentsClient,
+		WatchlistsClient:         &watchlistsClient,
 	}
 }
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type IncidentRelationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	IncidentName   string
+	RelationName   string
+}
+
+func NewIncidentRelationID(subscriptionId, resourceGroup, workspaceName, incidentName, relationName string) IncidentRelationId {
+	return IncidentRelationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		IncidentName:   incidentName,
+		RelationName:   relationName,
+	}
+}
+
+func (id IncidentRelationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Relation Name %q", id.RelationName),
+		fmt.Sprintf("Incident Name %q", id.IncidentName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Incident Relation", segmentsStr)
+}
+
+func (id IncidentRelationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/incidents/%s/relations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.IncidentName, id.RelationName)
+}
+
+// IncidentRelationID parses a IncidentRelation ID into an IncidentRelationId struct
+func IncidentRelationID(input string) (*IncidentRelationId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := IncidentRelationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.IncidentName, err = id.PopSegment("incidents"); err != nil {
+		return nil, err
+	}
+	if resourceId.RelationName, err = id.PopSegment("relations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -21,8 +21,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_sentinel_alert_rule":          dataSourceSentinelAlertRule(),
-		"azurerm_sentinel_alert_rule_template": dataSourceSentinelAlertRuleTemplate(),
+		"azurerm_sentinel_alert_rule":           dataSourceSentinelAlertRule(),
+		"azurerm_sentinel_alert_rule_template":  dataSourceSentinelAlertRuleTemplate(),
+		"azurerm_sentinel_alert_rule_templates": dataSourceSentinelAlertRuleTemplates(),
+		"azurerm_sentinel_automation_rule":      dataSourceSentinelAutomationRule(),
+		"azurerm_sentinel_automation_rules":     dataSourceSentinelAutomationRules(),
+		"azurerm_sentinel_incident":             dataSourceSentinelIncident(),
 	}
 }
 
@@ -41,5 +45,6 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtection(),
 		"azurerm_sentinel_data_connector_office_365":                                    resourceSentinelDataConnectorOffice365(),
 		"azurerm_sentinel_data_connector_threat_intelligence":                           resourceSentinelDataConnectorThreatIntelligence(),
+		"azurerm_sentinel_incident_relation":                                            resourceSentinelIncidentRelation(),
 	}
 }
@@ -3,13 +3,20 @@ package sentinel
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func alertRuleID(rule securityinsight.BasicAlertRule) *string {
@@ -30,6 +37,114 @@ func alertRuleID(rule securityinsight.BasicAlertRule) *string {
 	}
 }
 
+func alertRuleDisplayName(rule securityinsight.BasicAlertRule) *string {
+	if rule == nil {
+		return nil
+	}
+	switch rule := rule.(type) {
+	case securityinsight.FusionAlertRule:
+		if rule.FusionAlertRuleProperties != nil {
+			return rule.FusionAlertRuleProperties.DisplayName
+		}
+	case securityinsight.MicrosoftSecurityIncidentCreationAlertRule:
+		if rule.MicrosoftSecurityIncidentCreationAlertRuleProperties != nil {
+			return rule.MicrosoftSecurityIncidentCreationAlertRuleProperties.DisplayName
+		}
+	case securityinsight.ScheduledAlertRule:
+		if rule.ScheduledAlertRuleProperties != nil {
+			return rule.ScheduledAlertRuleProperties.DisplayName
+		}
+	case securityinsight.MLBehaviorAnalyticsAlertRule:
+		if rule.MLBehaviorAnalyticsAlertRuleProperties != nil {
+			return rule.MLBehaviorAnalyticsAlertRuleProperties.DisplayName
+		}
+	}
+	return nil
+}
+
+// validateSentinelAlertRuleImportId validates that the ID is either a Sentinel Alert Rule ID, or a
+// `{workspaceId}|{displayName}` selector as parsed by splitAlertRuleDisplayNameImportId.
+func validateSentinelAlertRuleImportId(id string) error {
+	if workspaceId, _, ok := splitAlertRuleDisplayNameImportId(id); ok {
+		_, err := loganalyticsParse.LogAnalyticsWorkspaceID(workspaceId)
+		return err
+	}
+
+	_, err := parse.AlertRuleID(id)
+	return err
+}
+
+// splitAlertRuleDisplayNameImportId splits a `{workspaceId}|{displayName}` import selector into its two parts.
+func splitAlertRuleDisplayNameImportId(id string) (workspaceId string, displayName string, ok bool) {
+	segments := strings.SplitN(id, "|", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", false
+	}
+
+	return segments[0], segments[1], true
+}
+
+// importSentinelAlertRuleByIdOrDisplayName allows the Sentinel Alert Rule to be imported using either its Resource ID
+// or a `{workspaceId}|{displayName}` selector, which is resolved to the Resource ID by listing the rules in the workspace.
+func importSentinelAlertRuleByIdOrDisplayName(expectKind securityinsight.AlertRuleKind) pluginsdk.ImporterFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (data []*pluginsdk.ResourceData, err error) {
+		if workspaceId, displayName, ok := splitAlertRuleDisplayNameImportId(d.Id()); ok {
+			workspace, err := loganalyticsParse.LogAnalyticsWorkspaceID(workspaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			client := meta.(*clients.Client).Sentinel.AlertRulesClient
+			id, err := findAlertRuleIdByDisplayName(ctx, client, *workspace, displayName, expectKind)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id.ID())
+		}
+
+		return importSentinelAlertRule(expectKind)(ctx, d, meta)
+	}
+}
+
+func findAlertRuleIdByDisplayName(ctx context.Context, client alertRulesReader, workspace loganalyticsParse.LogAnalyticsWorkspaceId, displayName string, expectKind securityinsight.AlertRuleKind) (*parse.AlertRuleId, error) {
+	it, err := client.ListComplete(ctx, workspace.ResourceGroup, OperationalInsightsResourceProvider, workspace.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Sentinel Alert Rules in %s: %+v", workspace, err)
+	}
+
+	var candidates []parse.AlertRuleId
+	for it.NotDone() {
+		rule := it.Value()
+		if name := alertRuleDisplayName(rule); name != nil && *name == displayName && assertAlertRuleKind(rule, expectKind) == nil {
+			if ruleId := alertRuleID(rule); ruleId != nil {
+				id, err := parse.AlertRuleID(*ruleId)
+				if err != nil {
+					return nil, err
+				}
+				candidates = append(candidates, *id)
+			}
+		}
+
+		if err := it.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Sentinel Alert Rules in %s: %+v", workspace, err)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no Sentinel Alert Rule of kind %q with the display name %q was found in %s", expectKind, displayName, workspace)
+	case 1:
+		return &candidates[0], nil
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID())
+	}
+	sort.Strings(ids)
+	return nil, fmt.Errorf("more than one Sentinel Alert Rule with the display name %q was found in %s, please import one of the following using its Resource ID: %s", displayName, workspace, strings.Join(ids, ", "))
+}
+
 func importSentinelAlertRule(expectKind securityinsight.AlertRuleKind) pluginsdk.ImporterFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (data []*pluginsdk.ResourceData, err error) {
 		id, err := parse.AlertRuleID(d.Id())
@@ -67,3 +182,46 @@ func assertAlertRuleKind(rule securityinsight.BasicAlertRule, expectKind securit
 	}
 	return nil
 }
+
+// alertRuleConflictRetryAttempts limits how many times an Alert Rule is written when it's been modified by someone else in the meantime.
+const alertRuleConflictRetryAttempts = 3
+
+// alertRuleConflictRetryInterval is how long to wait before retrieving the latest Etag and trying again.
+var alertRuleConflictRetryInterval = 5 * time.Second
+
+// retryAlertRuleOnConflict runs `upsert` up to `alertRuleConflictRetryAttempts` times, retrying whenever the Alert Rule has been
+// modified by someone else in the meantime - in which case `upsert` is expected to retrieve the latest Etag before trying again.
+func retryAlertRuleOnConflict(ctx context.Context, upsert func() (autorest.Response, error)) error {
+	var err error
+	for attempt := 1; attempt <= alertRuleConflictRetryAttempts; attempt++ {
+		var resp autorest.Response
+		resp, err = upsert()
+		if err == nil {
+			return nil
+		}
+
+		if !utils.ResponseWasConflict(resp) && !utils.ResponseWasStatusCode(resp, http.StatusPreconditionFailed) {
+			return err
+		}
+
+		if attempt < alertRuleConflictRetryAttempts {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(alertRuleConflictRetryInterval):
+			}
+		}
+	}
+
+	return err
+}
+
+// describeAlertRule identifies an Alert Rule by both its ID and its display name when reporting errors, since the
+// name of an Alert Rule is typically a GUID which is hard to tie back to the rule shown in the Portal.
+func describeAlertRule(id parse.AlertRuleId, displayName string) string {
+	if displayName == "" {
+		return id.String()
+	}
+
+	return fmt.Sprintf("%q (%s)", displayName, id)
+}
@@ -0,0 +1,157 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// alertRulesReader is the subset of the Alert Rules client used to retrieve Alert Rules.
+type alertRulesReader interface {
+	Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, ruleID string) (securityinsight.AlertRuleModel, error)
+	ListComplete(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string) (securityinsight.AlertRulesListIterator, error)
+}
+
+type alertRuleCacheEntry struct {
+	lock sync.Mutex
+
+	// rules is keyed by the lower-cased name of the Alert Rule, and is nil until populated.
+	rules map[string]securityinsight.BasicAlertRule
+
+	// stale contains the lower-cased names of the Alert Rules which have been written since the rules were listed.
+	stale map[string]struct{}
+}
+
+type alertRuleCache struct {
+	lock    sync.Mutex
+	entries map[string]*alertRuleCacheEntry
+}
+
+var alertRulesCache = &alertRuleCache{
+	entries: make(map[string]*alertRuleCacheEntry),
+}
+
+// getAlertRule retrieves the Alert Rule with the specified ID, returning nil if it doesn't exist.
+//
+// When the Sentinel Alert Rule read cache is enabled, all of the Alert Rules within the workspace
+// are listed once and subsequent lookups are served from the cache, other than those evicted after being written.
+func getAlertRule(ctx context.Context, client alertRulesReader, id parse.AlertRuleId) (securityinsight.BasicAlertRule, error) {
+	if features.SentinelAlertRuleReadCacheEnabled() {
+		return alertRulesCache.get(ctx, client, id)
+	}
+
+	return getAlertRuleUncached(ctx, client, id)
+}
+
+func getAlertRuleUncached(ctx context.Context, client alertRulesReader, id parse.AlertRuleId) (securityinsight.BasicAlertRule, error) {
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+func (c *alertRuleCache) get(ctx context.Context, client alertRulesReader, id parse.AlertRuleId) (securityinsight.BasicAlertRule, error) {
+	entry := c.entry(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.rules == nil {
+		rules, err := listAlertRules(ctx, client, id.ResourceGroup, id.WorkspaceName)
+		if err != nil {
+			return nil, err
+		}
+		entry.rules = rules
+		entry.stale = make(map[string]struct{})
+	}
+
+	name := strings.ToLower(id.Name)
+	if _, ok := entry.stale[name]; ok {
+		rule, err := getAlertRuleUncached(ctx, client, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if rule == nil {
+			delete(entry.rules, name)
+		} else {
+			entry.rules[name] = rule
+		}
+		delete(entry.stale, name)
+	}
+
+	return entry.rules[name], nil
+}
+
+// evict marks the cached Alert Rule as stale after it's been written, so that the next read retrieves only that Alert Rule again.
+func (c *alertRuleCache) evict(id parse.AlertRuleId) {
+	c.lock.Lock()
+	entry, ok := c.entries[alertRuleCacheKey(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)]
+	c.lock.Unlock()
+	if !ok {
+		return
+	}
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	// the Alert Rule is retrieved along with the others when the workspace hasn't been listed yet
+	if entry.rules != nil {
+		entry.stale[strings.ToLower(id.Name)] = struct{}{}
+	}
+}
+
+func (c *alertRuleCache) entry(subscriptionId, resourceGroup, workspaceName string) *alertRuleCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := alertRuleCacheKey(subscriptionId, resourceGroup, workspaceName)
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &alertRuleCacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
+
+func alertRuleCacheKey(subscriptionId, resourceGroup, workspaceName string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s", subscriptionId, resourceGroup, workspaceName))
+}
+
+func listAlertRules(ctx context.Context, client alertRulesReader, resourceGroup, workspaceName string) (map[string]securityinsight.BasicAlertRule, error) {
+	it, err := client.ListComplete(ctx, resourceGroup, OperationalInsightsResourceProvider, workspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Sentinel Alert Rules in Workspace %q (Resource Group %q): %+v", workspaceName, resourceGroup, err)
+	}
+
+	rules := make(map[string]securityinsight.BasicAlertRule)
+	for it.NotDone() {
+		rule := it.Value()
+		if ruleId := alertRuleID(rule); ruleId != nil {
+			id, err := parse.AlertRuleID(*ruleId)
+			if err != nil {
+				return nil, err
+			}
+			rules[strings.ToLower(id.Name)] = rule
+		}
+
+		if err := it.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Sentinel Alert Rules in Workspace %q (Resource Group %q): %+v", workspaceName, resourceGroup, err)
+		}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,215 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const alertRuleCacheEnvVar = "ARM_PROVIDER_SENTINEL_ALERT_RULE_CACHE"
+
+type fakeAlertRulesReader struct {
+	rules map[string]securityinsight.BasicAlertRule
+
+	getCalls  int
+	listCalls int
+}
+
+func newFakeAlertRulesReader(workspace parse.AlertRuleId, count int) *fakeAlertRulesReader {
+	rules := make(map[string]securityinsight.BasicAlertRule)
+	for i := 0; i < count; i++ {
+		id := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, fmt.Sprintf("rule%d", i))
+		rules[id.Name] = securityinsight.ScheduledAlertRule{
+			ID:   utils.String(id.ID()),
+			Name: utils.String(id.Name),
+		}
+	}
+	return &fakeAlertRulesReader{rules: rules}
+}
+
+func (f *fakeAlertRulesReader) Get(_ context.Context, _ string, _ string, _ string, ruleID string) (securityinsight.AlertRuleModel, error) {
+	f.getCalls++
+	rule, ok := f.rules[ruleID]
+	if !ok {
+		resp := autorest.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		return securityinsight.AlertRuleModel{Response: resp}, fmt.Errorf("not found")
+	}
+	return securityinsight.AlertRuleModel{Value: rule}, nil
+}
+
+func (f *fakeAlertRulesReader) ListComplete(_ context.Context, _ string, _ string, _ string) (securityinsight.AlertRulesListIterator, error) {
+	f.listCalls++
+	values := make([]securityinsight.BasicAlertRule, 0, len(f.rules))
+	for _, rule := range f.rules {
+		values = append(values, rule)
+	}
+	page := securityinsight.NewAlertRulesListPage(securityinsight.AlertRulesList{Value: &values}, func(context.Context, securityinsight.AlertRulesList) (securityinsight.AlertRulesList, error) {
+		return securityinsight.AlertRulesList{}, nil
+	})
+	return securityinsight.NewAlertRulesListIterator(page), nil
+}
+
+func TestAlertRuleReadCache(t *testing.T) {
+	os.Setenv(alertRuleCacheEnvVar, "true")
+	defer os.Unsetenv(alertRuleCacheEnvVar)
+
+	workspace := parse.NewAlertRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "")
+	client := newFakeAlertRulesReader(workspace, 10)
+	cache := &alertRuleCache{entries: make(map[string]*alertRuleCacheEntry)}
+	ctx := context.TODO()
+
+	for i := 0; i < 10; i++ {
+		id := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, fmt.Sprintf("RULE%d", i))
+		rule, err := cache.get(ctx, client, id)
+		if err != nil {
+			t.Fatalf("retrieving %s: %+v", id, err)
+		}
+		if rule == nil {
+			t.Fatalf("expected %s to be found in the cache", id)
+		}
+	}
+
+	missing := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "missing")
+	rule, err := cache.get(ctx, client, missing)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", missing, err)
+	}
+	if rule != nil {
+		t.Fatalf("expected %s not to be found in the cache", missing)
+	}
+
+	if client.listCalls != 1 || client.getCalls != 0 {
+		t.Fatalf("expected 1 list and 0 get calls, got %d list and %d get calls", client.listCalls, client.getCalls)
+	}
+}
+
+func TestAlertRuleReadCache_evict(t *testing.T) {
+	workspace := parse.NewAlertRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "")
+	client := newFakeAlertRulesReader(workspace, 10)
+	cache := &alertRuleCache{entries: make(map[string]*alertRuleCacheEntry)}
+	ctx := context.TODO()
+
+	updated := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "rule0")
+	deleted := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "rule1")
+	created := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "rule10")
+
+	// evicting before the workspace has been listed is a no-op
+	cache.evict(updated)
+
+	if _, err := cache.get(ctx, client, updated); err != nil {
+		t.Fatalf("retrieving %s: %+v", updated, err)
+	}
+
+	client.rules[updated.Name] = securityinsight.ScheduledAlertRule{
+		ID:   utils.String(updated.ID()),
+		Name: utils.String(updated.Name),
+		Etag: utils.String("updated"),
+	}
+	delete(client.rules, deleted.Name)
+	client.rules[created.Name] = securityinsight.ScheduledAlertRule{
+		ID:   utils.String(created.ID()),
+		Name: utils.String(created.Name),
+	}
+
+	cache.evict(updated)
+	cache.evict(deleted)
+	cache.evict(created)
+
+	rule, err := cache.get(ctx, client, updated)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", updated, err)
+	}
+	if etag := rule.(securityinsight.ScheduledAlertRule).Etag; etag == nil || *etag != "updated" {
+		t.Fatalf("expected %s to be retrieved again after eviction", updated)
+	}
+
+	if rule, err := cache.get(ctx, client, deleted); err != nil || rule != nil {
+		t.Fatalf("expected %s not to be found after eviction, got %+v: %+v", deleted, rule, err)
+	}
+
+	if rule, err := cache.get(ctx, client, created); err != nil || rule == nil {
+		t.Fatalf("expected %s to be found after eviction: %+v", created, err)
+	}
+
+	// rules which weren't evicted are still served from the cache
+	for i := 2; i < 10; i++ {
+		id := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, fmt.Sprintf("rule%d", i))
+		if _, err := cache.get(ctx, client, id); err != nil {
+			t.Fatalf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	if client.listCalls != 1 || client.getCalls != 3 {
+		t.Fatalf("expected 1 list and 3 get calls, got %d list and %d get calls", client.listCalls, client.getCalls)
+	}
+}
+
+func TestGetAlertRule_cacheDisabled(t *testing.T) {
+	os.Unsetenv(alertRuleCacheEnvVar)
+
+	workspace := parse.NewAlertRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "")
+	client := newFakeAlertRulesReader(workspace, 1)
+	ctx := context.TODO()
+
+	rule, err := getAlertRule(ctx, client, parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "rule0"))
+	if err != nil {
+		t.Fatalf("retrieving rule0: %+v", err)
+	}
+	if rule == nil {
+		t.Fatalf("expected rule0 to be found")
+	}
+
+	rule, err = getAlertRule(ctx, client, parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, "missing"))
+	if err != nil {
+		t.Fatalf("expected a missing rule not to return an error, got: %+v", err)
+	}
+	if rule != nil {
+		t.Fatalf("expected the missing rule not to be found")
+	}
+
+	if client.listCalls != 0 || client.getCalls != 2 {
+		t.Fatalf("expected 0 list and 2 get calls, got %d list and %d get calls", client.listCalls, client.getCalls)
+	}
+}
+
+func benchmarkAlertRuleRead(b *testing.B, cached bool) {
+	const ruleCount = 100
+	workspace := parse.NewAlertRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "")
+	client := newFakeAlertRulesReader(workspace, ruleCount)
+	ctx := context.TODO()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		// each iteration simulates a refresh of every rule in the workspace
+		cache := &alertRuleCache{entries: make(map[string]*alertRuleCacheEntry)}
+		for i := 0; i < ruleCount; i++ {
+			id := parse.NewAlertRuleID(workspace.SubscriptionId, workspace.ResourceGroup, workspace.WorkspaceName, fmt.Sprintf("rule%d", i))
+			var err error
+			if cached {
+				_, err = cache.get(ctx, client, id)
+			} else {
+				_, err = getAlertRuleUncached(ctx, client, id)
+			}
+			if err != nil {
+				b.Fatalf("retrieving %s: %+v", id, err)
+			}
+		}
+	}
+
+	b.ReportMetric(float64(client.getCalls+client.listCalls)/float64(b.N), "api-calls/refresh")
+}
+
+func BenchmarkAlertRuleRead_withoutCache(b *testing.B) {
+	benchmarkAlertRuleRead(b, false)
+}
+
+func BenchmarkAlertRuleRead_withCache(b *testing.B) {
+	benchmarkAlertRuleRead(b, true)
+}
@@ -0,0 +1,79 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryAlertRuleOnConflict(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCodes   []int
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "success",
+			StatusCodes:   []int{http.StatusOK},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "conflict then success",
+			StatusCodes:   []int{http.StatusConflict, http.StatusConflict, http.StatusOK},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "precondition failed then success",
+			StatusCodes:   []int{http.StatusPreconditionFailed, http.StatusOK},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "conflict on every attempt",
+			StatusCodes:   []int{http.StatusConflict, http.StatusConflict, http.StatusConflict, http.StatusOK},
+			ExpectedCalls: 3,
+			ExpectError:   true,
+		},
+		{
+			Name:          "bad request",
+			StatusCodes:   []int{http.StatusBadRequest, http.StatusOK},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+	}
+
+	interval := alertRuleConflictRetryInterval
+	alertRuleConflictRetryInterval = 0
+	defer func() {
+		alertRuleConflictRetryInterval = interval
+	}()
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		calls := 0
+		err := retryAlertRuleOnConflict(ctx, func() (autorest.Response, error) {
+			statusCode := tc.StatusCodes[calls]
+			calls++
+
+			resp := autorest.Response{Response: &http.Response{StatusCode: statusCode}}
+			if statusCode != http.StatusOK {
+				return resp, fmt.Errorf("unexpected status %d", statusCode)
+			}
+			return resp, nil
+		})
+		cancel()
+
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected an error: %t but got: %+v", tc.ExpectError, err)
+		}
+		if calls != tc.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %d", tc.ExpectedCalls, calls)
+		}
+	}
+}
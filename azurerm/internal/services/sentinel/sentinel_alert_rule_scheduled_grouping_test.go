@@ -0,0 +1,95 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+func TestValidateAlertRuleScheduledGrouping(t *testing.T) {
+	cases := []struct {
+		EntityMatchingMethod securityinsight.EntitiesMatchingMethod
+		GroupByCount         int
+		Valid                bool
+	}{
+		{
+			EntityMatchingMethod: securityinsight.All,
+			GroupByCount:         0,
+			Valid:                true,
+		},
+		{
+			EntityMatchingMethod: securityinsight.All,
+			GroupByCount:         1,
+			Valid:                false,
+		},
+		{
+			EntityMatchingMethod: securityinsight.None,
+			GroupByCount:         0,
+			Valid:                true,
+		},
+		{
+			EntityMatchingMethod: securityinsight.None,
+			GroupByCount:         2,
+			Valid:                false,
+		},
+		{
+			EntityMatchingMethod: securityinsight.Custom,
+			GroupByCount:         0,
+			Valid:                false,
+		},
+		{
+			EntityMatchingMethod: securityinsight.Custom,
+			GroupByCount:         2,
+			Valid:                true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q with %d group_by entities", tc.EntityMatchingMethod, tc.GroupByCount)
+
+		err := validateAlertRuleScheduledGrouping(string(tc.EntityMatchingMethod), tc.GroupByCount)
+		valid := err == nil
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestValidateAlertRuleScheduledGroupingReopen(t *testing.T) {
+	cases := []struct {
+		Enabled               bool
+		ReopenClosedIncidents bool
+		Valid                 bool
+	}{
+		{
+			Enabled:               true,
+			ReopenClosedIncidents: true,
+			Valid:                 true,
+		},
+		{
+			Enabled:               true,
+			ReopenClosedIncidents: false,
+			Valid:                 true,
+		},
+		{
+			Enabled:               false,
+			ReopenClosedIncidents: false,
+			Valid:                 true,
+		},
+		{
+			Enabled:               false,
+			ReopenClosedIncidents: true,
+			Valid:                 false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing grouping enabled %t with reopen_closed_incidents %t", tc.Enabled, tc.ReopenClosedIncidents)
+
+		err := validateAlertRuleScheduledGroupingReopen(tc.Enabled, tc.ReopenClosedIncidents)
+		valid := err == nil
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
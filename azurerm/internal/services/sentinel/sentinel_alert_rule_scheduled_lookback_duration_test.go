@@ -0,0 +1,53 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestAlertRuleScheduledGroupingLookbackDuration(t *testing.T) {
+	incidentConfiguration := resourceSentinelAlertRuleScheduled().Schema["incident_configuration"].Elem.(*schema.Resource)
+	grouping := incidentConfiguration.Schema["grouping"].Elem.(*schema.Resource)
+	validateFunc := grouping.Schema["lookback_duration"].ValidateFunc
+
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "PT4M59S",
+			Valid: false,
+		},
+		{
+			Input: "PT5M",
+			Valid: true,
+		},
+		{
+			Input: "PT1H",
+			Valid: true,
+		},
+		{
+			Input: "P7D",
+			Valid: true,
+		},
+		{
+			Input: "P7DT1M",
+			Valid: false,
+		},
+		{
+			Input: "5m",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		_, errors := validateFunc(tc.Input, "lookback_duration")
+		valid := len(errors) == 0
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, errors)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package sentinel
+
+import "testing"
+
+func TestValidateAlertRuleScheduledQueryPeriod(t *testing.T) {
+	cases := []struct {
+		QueryPeriod           string
+		AllowExtendedLookback bool
+		Valid                 bool
+	}{
+		{
+			QueryPeriod: "PT4M",
+			Valid:       false,
+		},
+		{
+			QueryPeriod: "PT5M",
+			Valid:       true,
+		},
+		{
+			QueryPeriod: "P14D",
+			Valid:       true,
+		},
+		{
+			QueryPeriod: "P15D",
+			Valid:       false,
+		},
+		{
+			QueryPeriod:           "P15D",
+			AllowExtendedLookback: true,
+			Valid:                 true,
+		},
+		{
+			QueryPeriod:           "P90D",
+			AllowExtendedLookback: true,
+			Valid:                 true,
+		},
+		{
+			QueryPeriod:           "PT4M",
+			AllowExtendedLookback: true,
+			Valid:                 false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q (allow_extended_lookback: %t)", tc.QueryPeriod, tc.AllowExtendedLookback)
+
+		err := validateAlertRuleScheduledQueryPeriod(tc.QueryPeriod, tc.AllowExtendedLookback)
+		valid := err == nil
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
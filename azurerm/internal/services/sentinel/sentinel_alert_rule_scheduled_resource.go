@@ -1,11 +1,14 @@
 package sentinel
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/rickb777/date/period"
@@ -20,6 +23,25 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const (
+	alertRuleScheduledFrequencyPresetEvery5Minutes = "every_5_minutes"
+	alertRuleScheduledFrequencyPresetHourly        = "hourly"
+	alertRuleScheduledFrequencyPresetDaily         = "daily"
+)
+
+const alertRuleScheduledDefaultSuppressionDuration = "PT5H"
+
+// alertRuleScheduledFrequencyPresets maps each `frequency_preset` to the `query_frequency` and `query_period` it's expanded to,
+// querying the data since the previous run so there are no gaps in the overall query coverage.
+var alertRuleScheduledFrequencyPresets = map[string]struct {
+	queryFrequency string
+	queryPeriod    string
+}{
+	alertRuleScheduledFrequencyPresetEvery5Minutes: {queryFrequency: "PT5M", queryPeriod: "PT5M"},
+	alertRuleScheduledFrequencyPresetHourly:        {queryFrequency: "PT1H", queryPeriod: "PT1H"},
+	alertRuleScheduledFrequencyPresetDaily:         {queryFrequency: "P1D", queryPeriod: "P1D"},
+}
+
 func resourceSentinelAlertRuleScheduled() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSentinelAlertRuleScheduledCreateUpdate,
@@ -27,10 +49,9 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 		Update: resourceSentinelAlertRuleScheduledCreateUpdate,
 		Delete: resourceSentinelAlertRuleScheduledDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.AlertRuleID(id)
-			return err
-		}, importSentinelAlertRule(securityinsight.AlertRuleKindScheduled)),
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(validateSentinelAlertRuleImportId, importSentinelAlertRuleByIdOrDisplayName(securityinsight.AlertRuleKindScheduled)),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(alertRuleScheduledCustomizeDiff),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -94,6 +115,7 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 			"tactics": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringInSlice([]string{
@@ -140,7 +162,7 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 									"lookback_duration": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: validate.ISO8601Duration,
+										ValidateFunc: validate.ISO8601DurationBetween("PT5M", "P7D"),
 										Default:      "PT5M",
 									},
 									"reopen_closed_incidents": {
@@ -180,7 +202,8 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 
 			"severity": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(securityinsight.High),
 					string(securityinsight.Medium),
@@ -197,22 +220,44 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 
 			"query": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"query_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "PT5H",
-				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT24H"),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "PT5H",
+				ValidateFunc:     validate.ISO8601DurationBetween("PT5M", "PT24H"),
+				ConflictsWith:    []string{"frequency_preset"},
+				DiffSuppressFunc: suppressAlertRuleScheduledFrequencyPresetDiff,
 			},
 
 			"query_period": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "PT5H",
-				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "P14D"),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "PT5H",
+				ValidateFunc:     validate.ISO8601Duration,
+				ConflictsWith:    []string{"frequency_preset"},
+				DiffSuppressFunc: suppressAlertRuleScheduledFrequencyPresetDiff,
+			},
+
+			"frequency_preset": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					alertRuleScheduledFrequencyPresetEvery5Minutes,
+					alertRuleScheduledFrequencyPresetHourly,
+					alertRuleScheduledFrequencyPresetDaily,
+				}, false),
+				ConflictsWith: []string{"query_frequency", "query_period"},
+			},
+
+			"allow_extended_lookback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"trigger_operator": {
@@ -242,9 +287,29 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 			"suppression_duration": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "PT5H",
+				Default:      alertRuleScheduledDefaultSuppressionDuration,
 				ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT24H"),
 			},
+
+			"watchlist_aliases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"disable_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"last_modified_utc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -260,12 +325,13 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta
 		return err
 	}
 	id := parse.NewAlertRuleID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+	identifier := describeAlertRule(id, d.Get("display_name").(string))
 
 	if d.IsNewResource() {
 		resp, err := client.Get(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("checking for existing Sentinel Alert Rule Scheduled %q: %+v", id, err)
+				return fmt.Errorf("checking for existing Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
 			}
 		}
 
@@ -275,13 +341,50 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta
 		}
 	}
 
+	query := d.Get("query").(string)
+	severity := d.Get("severity").(string)
+	tactics := expandAlertRuleScheduledTactics(d.Get("tactics").(*schema.Set).List())
+
+	// The query, severity and tactics can be derived from the alert rule template on creation, explicitly specified values take precedence.
+	if templateGuid, ok := d.GetOk("alert_rule_template_guid"); ok && d.IsNewResource() && (query == "" || severity == "" || len(*tactics) == 0) {
+		templateClient := meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient
+		template, err := getAlertRuleScheduledTemplate(ctx, templateClient, workspaceID, templateGuid.(string))
+		if err != nil {
+			return fmt.Errorf("retrieving Sentinel Alert Rule Template %q for %s: %+v", templateGuid.(string), identifier, err)
+		}
+
+		if prop := template.ScheduledAlertRuleTemplateProperties; prop != nil {
+			if query == "" && prop.Query != nil {
+				query = *prop.Query
+			}
+			if severity == "" {
+				severity = string(prop.Severity)
+			}
+			if len(*tactics) == 0 && prop.Tactics != nil {
+				tactics = prop.Tactics
+			}
+		}
+	}
+
+	if err := validateAlertRuleScheduledQueryAndSeverity(query, severity); err != nil {
+		return err
+	}
+
 	// Sanity checks
 
 	// query frequency must <= query period: ensure there is no gaps in the overall query coverage.
 	queryFreq := d.Get("query_frequency").(string)
+	queryPeriod := d.Get("query_period").(string)
+	if v, ok := d.GetOk("frequency_preset"); ok {
+		preset := alertRuleScheduledFrequencyPresets[v.(string)]
+		queryFreq = preset.queryFrequency
+		queryPeriod = preset.queryPeriod
+	}
 	queryFreqDuration := period.MustParse(queryFreq).DurationApprox()
 
-	queryPeriod := d.Get("query_period").(string)
+	if err := validateAlertRuleScheduledQueryPeriod(queryPeriod, d.Get("allow_extended_lookback").(bool)); err != nil {
+		return err
+	}
 	queryPeriodDuration := period.MustParse(queryPeriod).DurationApprox()
 	if queryFreqDuration > queryPeriodDuration {
 		return fmt.Errorf("`query_frequency`(%v) should not be larger than `query period`(%v), which introduce gaps in the overall query coverage", queryFreq, queryPeriod)
@@ -302,11 +405,11 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta
 		ScheduledAlertRuleProperties: &securityinsight.ScheduledAlertRuleProperties{
 			Description:           utils.String(d.Get("description").(string)),
 			DisplayName:           utils.String(d.Get("display_name").(string)),
-			Tactics:               expandAlertRuleScheduledTactics(d.Get("tactics").(*schema.Set).List()),
+			Tactics:               tactics,
 			IncidentConfiguration: expandAlertRuleScheduledIncidentConfiguration(d.Get("incident_configuration").([]interface{})),
-			Severity:              securityinsight.AlertSeverity(d.Get("severity").(string)),
+			Severity:              securityinsight.AlertSeverity(severity),
 			Enabled:               utils.Bool(d.Get("enabled").(bool)),
-			Query:                 utils.String(d.Get("query").(string)),
+			Query:                 utils.String(query),
 			QueryFrequency:        &queryFreq,
 			QueryPeriod:           &queryPeriod,
 			SuppressionEnabled:    &suppressionEnabled,
@@ -325,21 +428,27 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta
 	}
 
 	// Service avoid concurrent update of this resource via checking the "etag" to guarantee it is the same value as last Read.
-	if !d.IsNewResource() {
-		resp, err := client.Get(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name)
-		if err != nil {
-			return fmt.Errorf("retrieving Sentinel Alert Rule Scheduled %q: %+v", id, err)
-		}
+	// Since the rule may be modified in-between (e.g. when toggling `enabled` in quick succession), the "etag" is refreshed on each attempt.
+	err = retryAlertRuleOnConflict(ctx, func() (autorest.Response, error) {
+		if !d.IsNewResource() {
+			resp, err := client.Get(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name)
+			if err != nil {
+				return resp.Response, err
+			}
 
-		if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindScheduled); err != nil {
-			return fmt.Errorf("asserting alert rule of %q: %+v", id, err)
+			if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindScheduled); err != nil {
+				return autorest.Response{}, err
+			}
+			param.Etag = resp.Value.(securityinsight.ScheduledAlertRule).Etag
 		}
-		param.Etag = resp.Value.(securityinsight.ScheduledAlertRule).Etag
-	}
 
-	if _, err := client.CreateOrUpdate(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name, param); err != nil {
-		return fmt.Errorf("creating Sentinel Alert Rule Scheduled %q: %+v", id, err)
+		resp, err := client.CreateOrUpdate(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name, param)
+		return resp.Response, err
+	})
+	if err != nil {
+		return fmt.Errorf("creating Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
 	}
+	alertRulesCache.evict(id)
 
 	d.SetId(id.ID())
 
@@ -355,28 +464,34 @@ func resourceSentinelAlertRuleScheduledRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	identifier := describeAlertRule(*id, d.Get("display_name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	value, err := getAlertRule(ctx, client, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Sentinel Alert Rule Scheduled %q was not found - removing from state!", id)
-			d.SetId("")
-			return nil
-		}
-
-		return fmt.Errorf("retrieving Sentinel Alert Rule Scheduled %q: %+v", id, err)
+		return fmt.Errorf("retrieving Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
+	}
+	if value == nil {
+		log.Printf("[DEBUG] Sentinel Alert Rule Scheduled %s was not found - removing from state!", identifier)
+		d.SetId("")
+		return nil
 	}
 
-	if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindScheduled); err != nil {
-		return fmt.Errorf("asserting alert rule of %q: %+v", id, err)
+	if err := assertAlertRuleKind(value, securityinsight.AlertRuleKindScheduled); err != nil {
+		return fmt.Errorf("asserting alert rule of %s: %+v", identifier, err)
 	}
-	rule := resp.Value.(securityinsight.ScheduledAlertRule)
+	rule := value.(securityinsight.ScheduledAlertRule)
 
 	d.Set("name", id.Name)
 
 	workspaceId := loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
 	d.Set("log_analytics_workspace_id", workspaceId.ID())
 
+	// `disable_on_destroy`, `allow_extended_lookback` and `frequency_preset` only control the behaviour of the provider and aren't returned from the API.
+	d.Set("disable_on_destroy", d.Get("disable_on_destroy").(bool))
+	d.Set("allow_extended_lookback", d.Get("allow_extended_lookback").(bool))
+	d.Set("frequency_preset", d.Get("frequency_preset").(string))
+	d.Set("watchlist_aliases", d.Get("watchlist_aliases").(*schema.Set).List())
+
 	if prop := rule.ScheduledAlertRuleProperties; prop != nil {
 		d.Set("description", prop.Description)
 		d.Set("display_name", prop.DisplayName)
@@ -386,7 +501,7 @@ func resourceSentinelAlertRuleScheduledRead(d *schema.ResourceData, meta interfa
 		if err := d.Set("incident_configuration", flattenAlertRuleScheduledIncidentConfiguration(prop.IncidentConfiguration)); err != nil {
 			return fmt.Errorf("setting `incident_configuration`: %+v", err)
 		}
-		d.Set("severity", string(prop.Severity))
+		d.Set("severity", normalizeAlertRuleScheduledSeverity(prop.Severity))
 		d.Set("enabled", prop.Enabled)
 		d.Set("query", prop.Query)
 		d.Set("query_frequency", prop.QueryFrequency)
@@ -399,9 +514,16 @@ func resourceSentinelAlertRuleScheduledRead(d *schema.ResourceData, meta interfa
 		}
 
 		d.Set("trigger_threshold", int(threshold))
-		d.Set("suppression_enabled", prop.SuppressionEnabled)
-		d.Set("suppression_duration", prop.SuppressionDuration)
-		d.Set("alert_rule_template_guid", prop.AlertRuleTemplateName)
+		suppressionEnabled, suppressionDuration := flattenAlertRuleScheduledSuppression(prop.SuppressionEnabled, prop.SuppressionDuration)
+		d.Set("suppression_enabled", suppressionEnabled)
+		d.Set("suppression_duration", suppressionDuration)
+		d.Set("alert_rule_template_guid", flattenAlertRuleScheduledTemplateGuid(d.Get("alert_rule_template_guid").(string), prop.AlertRuleTemplateName))
+
+		lastModifiedUtc := ""
+		if prop.LastModifiedUtc != nil {
+			lastModifiedUtc = prop.LastModifiedUtc.Format(time.RFC3339)
+		}
+		d.Set("last_modified_utc", lastModifiedUtc)
 
 		if err := d.Set("event_grouping", flattenAlertRuleScheduledEventGroupingSetting(prop.EventGroupingSettings)); err != nil {
 			return fmt.Errorf("setting `event_grouping`: %+v", err)
@@ -420,14 +542,218 @@ func resourceSentinelAlertRuleScheduledDelete(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
+	identifier := describeAlertRule(*id, d.Get("display_name").(string))
+
+	// The rule is kept (disabled) rather than deleted, to retain its history.
+	if d.Get("disable_on_destroy").(bool) {
+		resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
+		}
+
+		if err := assertAlertRuleKind(resp.Value, securityinsight.AlertRuleKindScheduled); err != nil {
+			return fmt.Errorf("asserting alert rule of %s: %+v", identifier, err)
+		}
+		rule := resp.Value.(securityinsight.ScheduledAlertRule)
+		if rule.ScheduledAlertRuleProperties == nil {
+			return fmt.Errorf("retrieving Sentinel Alert Rule Scheduled %s: `properties` was nil", identifier)
+		}
+		rule.ScheduledAlertRuleProperties.Enabled = utils.Bool(false)
+
+		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, rule); err != nil {
+			return fmt.Errorf("disabling Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
+		}
+		alertRulesCache.evict(*id)
+
+		return nil
+	}
 
 	if _, err := client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name); err != nil {
-		return fmt.Errorf("deleting Sentinel Alert Rule Scheduled %q: %+v", id, err)
+		return fmt.Errorf("deleting Sentinel Alert Rule Scheduled %s: %+v", identifier, err)
+	}
+	alertRulesCache.evict(*id)
+
+	return nil
+}
+
+// validateAlertRuleScheduledQueryAndSeverity ensures `query` and `severity` have either been specified or derived from the Alert Rule Template.
+func validateAlertRuleScheduledQueryAndSeverity(query, severity string) error {
+	if query == "" {
+		return fmt.Errorf("`query` must be specified when it can't be derived from `alert_rule_template_guid`")
+	}
+	if severity == "" {
+		return fmt.Errorf("`severity` must be specified when it can't be derived from `alert_rule_template_guid`")
+	}
+
+	return nil
+}
+
+// validateAlertRuleScheduledQueryPeriod ensures `query_period` is between 5 minutes and 14 days. The upper limit can be
+// lifted via `allow_extended_lookback` for (preview) workspaces supporting longer lookbacks, in which case the API decides.
+func validateAlertRuleScheduledQueryPeriod(queryPeriod string, allowExtendedLookback bool) error {
+	p, err := period.Parse(queryPeriod)
+	if err != nil {
+		return fmt.Errorf("parsing `query_period` %q: %+v", queryPeriod, err)
+	}
+	duration := p.DurationApprox()
+
+	if min := period.MustParse("PT5M").DurationApprox(); duration < min {
+		return fmt.Errorf("`query_period`(%v) should not be shorter than `PT5M`", queryPeriod)
+	}
+
+	if max := period.MustParse("P14D").DurationApprox(); !allowExtendedLookback && duration > max {
+		return fmt.Errorf("`query_period`(%v) should not be longer than `P14D` unless `allow_extended_lookback` is enabled", queryPeriod)
 	}
 
 	return nil
 }
 
+// flattenAlertRuleScheduledSuppression reconciles the suppression settings returned by the API, since older rules can report
+// suppression as enabled alongside a zero duration - which is treated the same as suppression being disabled with the default duration.
+func flattenAlertRuleScheduledSuppression(enabled *bool, duration *string) (bool, string) {
+	suppressionEnabled := enabled != nil && *enabled
+	suppressionDuration := ""
+	if duration != nil {
+		suppressionDuration = *duration
+	}
+
+	if p, err := period.Parse(suppressionDuration); suppressionDuration == "" || (err == nil && p.IsZero()) {
+		return false, alertRuleScheduledDefaultSuppressionDuration
+	}
+
+	return suppressionEnabled, suppressionDuration
+}
+
+// flattenAlertRuleScheduledTemplateGuid keeps the configured template GUID when the API no longer returns one, since Sentinel
+// can detach the template from a rule once its query has been customised - which would otherwise force a new rule to be created.
+func flattenAlertRuleScheduledTemplateGuid(configured string, returned *string) string {
+	if returned == nil || *returned == "" {
+		return configured
+	}
+
+	return *returned
+}
+
+func alertRuleScheduledCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("incident_configuration") {
+		for _, incidentConfig := range d.Get("incident_configuration").([]interface{}) {
+			if incidentConfig == nil {
+				continue
+			}
+			for _, grouping := range incidentConfig.(map[string]interface{})["grouping"].([]interface{}) {
+				if grouping == nil {
+					continue
+				}
+				raw := grouping.(map[string]interface{})
+				if err := validateAlertRuleScheduledGrouping(raw["entity_matching_method"].(string), raw["group_by"].(*schema.Set).Len()); err != nil {
+					return err
+				}
+				if err := validateAlertRuleScheduledGroupingReopen(raw["enabled"].(bool), raw["reopen_closed_incidents"].(bool)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// without an Alert Rule Template there's nothing to derive `query` and `severity` from
+	if d.NewValueKnown("alert_rule_template_guid") && d.Get("alert_rule_template_guid").(string) == "" && d.NewValueKnown("query") && d.NewValueKnown("severity") {
+		if err := validateAlertRuleScheduledQueryAndSeverity(d.Get("query").(string), d.Get("severity").(string)); err != nil {
+			return err
+		}
+	}
+
+	// `query_period` is derived from `frequency_preset` when it's specified, so is only checked otherwise
+	if d.NewValueKnown("frequency_preset") && d.Get("frequency_preset").(string) == "" && d.NewValueKnown("query_period") && d.NewValueKnown("allow_extended_lookback") {
+		if err := validateAlertRuleScheduledQueryPeriod(d.Get("query_period").(string), d.Get("allow_extended_lookback").(bool)); err != nil {
+			return err
+		}
+	}
+
+	// the Watchlists are only looked up when the rule is created or the aliases change, and only once the Workspace exists
+	if (d.Id() == "" || d.HasChange("watchlist_aliases")) && d.NewValueKnown("log_analytics_workspace_id") && d.NewValueKnown("watchlist_aliases") {
+		aliases := *utils.ExpandStringSlice(d.Get("watchlist_aliases").(*schema.Set).List())
+		if len(aliases) > 0 {
+			workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+			defer cancel()
+
+			missing, err := findMissingSentinelWatchlistAliases(ctx, meta.(*clients.Client).Sentinel.WatchlistsClient, *workspaceID, aliases)
+			if err != nil {
+				return err
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("the following `watchlist_aliases` were not found in %s: %s", workspaceID, strings.Join(missing, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAlertRuleScheduledGrouping ensures `group_by` is only (and always) specified when the `entity_matching_method` is `Custom`.
+func validateAlertRuleScheduledGrouping(entityMatchingMethod string, groupByCount int) error {
+	if entityMatchingMethod == string(securityinsight.Custom) {
+		if groupByCount == 0 {
+			return fmt.Errorf("`group_by` must be specified when `entity_matching_method` is `%s`", securityinsight.Custom)
+		}
+		return nil
+	}
+
+	if groupByCount > 0 {
+		return fmt.Errorf("`group_by` can only be specified when `entity_matching_method` is `%s`, got `%s`", securityinsight.Custom, entityMatchingMethod)
+	}
+
+	return nil
+}
+
+// validateAlertRuleScheduledGroupingReopen ensures `reopen_closed_incidents` is only enabled alongside grouping.
+func validateAlertRuleScheduledGroupingReopen(enabled, reopenClosedIncidents bool) error {
+	if reopenClosedIncidents && !enabled {
+		return fmt.Errorf("`reopen_closed_incidents` can only be enabled when `enabled` is `true` within the `grouping` block")
+	}
+
+	return nil
+}
+
+// suppressAlertRuleScheduledFrequencyPresetDiff suppresses the diff of `query_frequency` and `query_period` when they're
+// derived from `frequency_preset`, since their (default) values in the configuration aren't used.
+func suppressAlertRuleScheduledFrequencyPresetDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Get("frequency_preset").(string) != ""
+}
+
+// normalizeAlertRuleScheduledSeverity returns the canonical casing of the severity, since older rules can return it in a different casing.
+func normalizeAlertRuleScheduledSeverity(input securityinsight.AlertSeverity) string {
+	for _, severity := range securityinsight.PossibleAlertSeverityValues() {
+		if strings.EqualFold(string(input), string(severity)) {
+			return string(severity)
+		}
+	}
+
+	return string(input)
+}
+
+func getAlertRuleScheduledTemplate(ctx context.Context, client alertRuleTemplatesGetter, workspaceID *loganalyticsParse.LogAnalyticsWorkspaceId, name string) (*securityinsight.ScheduledAlertRuleTemplate, error) {
+	resp, err := alertRuleTemplatesCache.get(ctx, client, workspaceID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	template, ok := resp.(securityinsight.ScheduledAlertRuleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("Sentinel Alert Rule Template %q is not a Scheduled Alert Rule Template", name)
+	}
+
+	return &template, nil
+}
+
 func expandAlertRuleScheduledTactics(input []interface{}) *[]securityinsight.AttackTactic {
 	result := make([]securityinsight.AttackTactic, 0)
 
@@ -3,6 +3,7 @@ package sentinel_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
@@ -27,6 +28,7 @@ func TestAccSentinelAlertRuleScheduled_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_modified_utc").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -77,6 +79,280 @@ func TestAccSentinelAlertRuleScheduled_update(t *testing.T) {
 	})
 }
 
+func TestAccSentinelAlertRuleScheduled_updateDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Some Rule"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.renamed(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Renamed Rule"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_groupingWithDefaults(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.groupingWithDefaults(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("incident_configuration.0.grouping.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("incident_configuration.0.grouping.0.reopen_closed_incidents").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_groupingDuplicateEntities(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.groupingDuplicateEntities(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("incident_configuration.0.grouping.0.group_by.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_groupingReopenWhenDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.groupingReopenWhenDisabled(data),
+			ExpectError: regexp.MustCompile("`reopen_closed_incidents` can only be enabled when `enabled` is `true`"),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_queryPeriodTooLong(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.queryPeriod(data, "P15D"),
+			ExpectError: regexp.MustCompile("should not be longer than `P14D` unless `allow_extended_lookback` is enabled"),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_missingQueryWithoutTemplate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.missingQueryWithoutTemplate(data),
+			ExpectError: regexp.MustCompile("`query` must be specified when it can't be derived from `alert_rule_template_guid`"),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_importByDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(state *terraform.State) (string, error) {
+				rs, ok := state.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+				return fmt.Sprintf("%s|%s", rs.Primary.Attributes["log_analytics_workspace_id"], rs.Primary.Attributes["display_name"]), nil
+			},
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_frequencyPreset(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.frequencyPreset(data, "every_5_minutes"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("PT5M"),
+				check.That(data.ResourceName).Key("query_period").HasValue("PT5M"),
+			),
+		},
+		data.ImportStep("frequency_preset"),
+		{
+			Config: r.frequencyPreset(data, "hourly"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("PT1H"),
+				check.That(data.ResourceName).Key("query_period").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep("frequency_preset"),
+		{
+			Config: r.frequencyPreset(data, "daily"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("P1D"),
+				check.That(data.ResourceName).Key("query_period").HasValue("P1D"),
+			),
+		},
+		data.ImportStep("frequency_preset"),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_triggerOperator(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.triggerOperator(data, "Equal", 0),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("Equal"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.triggerOperator(data, "NotEqual", 1),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("NotEqual"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.triggerOperator(data, "GreaterThan", 2),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("GreaterThan"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.triggerOperator(data, "LessThan", 3),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("LessThan"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_toggleEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.toggleEnabled(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.toggleEnabled(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		{
+			Config: r.toggleEnabled(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.toggleEnabled(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_missingWatchlistAlias(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			// the Workspace has to exist before the Watchlists within it can be checked
+			Config: r.template(data),
+		},
+		{
+			Config:      r.missingWatchlistAlias(data),
+			ExpectError: regexp.MustCompile("the following `watchlist_aliases` were not found"),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_noTactics(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.noTactics(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tactics.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSentinelAlertRuleScheduled_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
 	r := SentinelAlertRuleScheduledResource{}
@@ -101,6 +377,44 @@ func TestAccSentinelAlertRuleScheduled_withAlertRuleTemplateGuid(t *testing.T) {
 			Config: r.alertRuleTemplateGuid(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				// the service defaults the incident configuration, which is stored since it's not specified
+				check.That(data.ResourceName).Key("incident_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("incident_configuration.0.create_incident").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_populatedFromAlertRuleTemplate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.populatedFromAlertRuleTemplate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.query")),
+				check.That(data.ResourceName).Key("severity").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.severity")),
+				check.That(data.ResourceName).Key("tactics.#").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.tactics.#")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelAlertRuleScheduled_populatedFromAlertRuleTemplateWithOverrides(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.populatedFromAlertRuleTemplateWithOverrides(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query").MatchesOtherKey(check.That("data.azurerm_sentinel_alert_rule_template.test").Key("scheduled_template.0.query")),
+				check.That(data.ResourceName).Key("severity").HasValue("Informational"),
 			),
 		},
 		data.ImportStep(),
@@ -129,6 +443,21 @@ func TestAccSentinelAlertRuleScheduled_updateEventGroupingSetting(t *testing.T)
 	})
 }
 
+func TestAccSentinelAlertRuleScheduled_disableOnDestroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled", "test")
+	r := SentinelAlertRuleScheduledDisableOnDestroyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.disableOnDestroy(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("disable_on_destroy"),
+	})
+}
+
 func (t SentinelAlertRuleScheduledResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.AlertRuleID(state.ID)
 	if err != nil {
@@ -148,6 +477,31 @@ func (t SentinelAlertRuleScheduledResource) Exists(ctx context.Context, clients
 	return utils.Bool(rule.ID != nil), nil
 }
 
+// SentinelAlertRuleScheduledDisableOnDestroyResource considers a disabled rule as destroyed, since `disable_on_destroy` leaves the rule in place.
+type SentinelAlertRuleScheduledDisableOnDestroyResource struct {
+	SentinelAlertRuleScheduledResource
+}
+
+func (t SentinelAlertRuleScheduledDisableOnDestroyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.AlertRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.AlertRulesClient.Get(ctx, id.ResourceGroup, "Microsoft.OperationalInsights", id.WorkspaceName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading Sentinel Alert Rule Scheduled %q: %v", id, err)
+	}
+
+	rule, ok := resp.Value.(securityinsight.ScheduledAlertRule)
+	if !ok {
+		return nil, fmt.Errorf("the Alert Rule %q is not a Scheduled Alert Rule", id)
+	}
+
+	enabled := rule.ScheduledAlertRuleProperties != nil && rule.Enabled != nil && *rule.Enabled
+	return utils.Bool(enabled), nil
+}
+
 func (r SentinelAlertRuleScheduledResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -167,6 +521,135 @@ QUERY
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SentinelAlertRuleScheduledResource) queryPeriod(data acceptance.TestData, queryPeriod string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  query_period               = "%s"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger, queryPeriod)
+}
+
+func (r SentinelAlertRuleScheduledResource) missingQueryWithoutTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) toggleEnabled(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  enabled                    = %t
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger, enabled)
+}
+
+func (r SentinelAlertRuleScheduledResource) missingWatchlistAlias(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  watchlist_aliases          = ["acctest-missing-%d"]
+  query                      = <<QUERY
+_GetWatchlist('acctest-missing-%d')
+QUERY
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) noTactics(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  tactics                    = []
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) renamed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Renamed Rule"
+  severity                   = "High"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) disableOnDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  disable_on_destroy         = true
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SentinelAlertRuleScheduledResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -201,10 +684,137 @@ QUERY
   trigger_threshold    = 5
   suppression_enabled  = true
   suppression_duration = "PT40M"
+
+  event_grouping {
+    aggregation_method = "AlertPerResult"
+  }
 }
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SentinelAlertRuleScheduledResource) groupingWithDefaults(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+
+  incident_configuration {
+    create_incident = true
+    grouping {
+      enabled = true
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+// groupingDuplicateEntities specifies duplicate entity types within `group_by`, which should be collapsed into a single entry
+func (r SentinelAlertRuleScheduledResource) groupingDuplicateEntities(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+
+  incident_configuration {
+    create_incident = true
+    grouping {
+      enabled                = true
+      entity_matching_method = "Custom"
+      group_by               = ["Account", "Host", "Account"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) groupingReopenWhenDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+
+  incident_configuration {
+    create_incident = true
+    grouping {
+      enabled                 = false
+      reopen_closed_incidents = true
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) triggerOperator(data acceptance.TestData, operator string, threshold int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  trigger_operator           = "%s"
+  trigger_threshold          = %d
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger, operator, threshold)
+}
+
+func (r SentinelAlertRuleScheduledResource) frequencyPreset(data acceptance.TestData, preset string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  frequency_preset           = "%s"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, r.template(data), data.RandomInteger, preset)
+}
+
 func (r SentinelAlertRuleScheduledResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -239,6 +849,43 @@ QUERY
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SentinelAlertRuleScheduledResource) populatedFromAlertRuleTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_template" "test" {
+  name                       = "65360bb0-8986-4ade-a89d-af3cf44d28aa"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  alert_rule_template_guid   = data.azurerm_sentinel_alert_rule_template.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SentinelAlertRuleScheduledResource) populatedFromAlertRuleTemplateWithOverrides(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_template" "test" {
+  name                       = "65360bb0-8986-4ade-a89d-af3cf44d28aa"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+
+resource "azurerm_sentinel_alert_rule_scheduled" "test" {
+  name                       = "acctest-SentinelAlertRule-Sche-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "Informational"
+  alert_rule_template_guid   = data.azurerm_sentinel_alert_rule_template.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SentinelAlertRuleScheduledResource) eventGroupingSetting(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
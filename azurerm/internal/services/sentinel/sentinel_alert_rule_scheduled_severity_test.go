@@ -0,0 +1,44 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+func TestNormalizeAlertRuleScheduledSeverity(t *testing.T) {
+	cases := []struct {
+		Input    securityinsight.AlertSeverity
+		Expected string
+	}{
+		{
+			Input:    securityinsight.High,
+			Expected: "High",
+		},
+		{
+			Input:    securityinsight.AlertSeverity("high"),
+			Expected: "High",
+		},
+		{
+			Input:    securityinsight.AlertSeverity("INFORMATIONAL"),
+			Expected: "Informational",
+		},
+		{
+			Input:    securityinsight.AlertSeverity(""),
+			Expected: "",
+		},
+		{
+			// unknown values are returned as-is
+			Input:    securityinsight.AlertSeverity("Critical"),
+			Expected: "Critical",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		if actual := normalizeAlertRuleScheduledSeverity(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenAlertRuleScheduledSuppression(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Enabled          *bool
+		Duration         *string
+		ExpectedEnabled  bool
+		ExpectedDuration string
+	}{
+		{
+			Name:             "nil",
+			ExpectedEnabled:  false,
+			ExpectedDuration: "PT5H",
+		},
+		{
+			Name:             "disabled",
+			Enabled:          utils.Bool(false),
+			Duration:         utils.String("PT5H"),
+			ExpectedEnabled:  false,
+			ExpectedDuration: "PT5H",
+		},
+		{
+			Name:             "enabled",
+			Enabled:          utils.Bool(true),
+			Duration:         utils.String("PT1H"),
+			ExpectedEnabled:  true,
+			ExpectedDuration: "PT1H",
+		},
+		{
+			Name:             "enabled with a zero duration",
+			Enabled:          utils.Bool(true),
+			Duration:         utils.String("PT0S"),
+			ExpectedEnabled:  false,
+			ExpectedDuration: "PT5H",
+		},
+		{
+			Name:             "enabled without a duration",
+			Enabled:          utils.Bool(true),
+			Duration:         utils.String(""),
+			ExpectedEnabled:  false,
+			ExpectedDuration: "PT5H",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		enabled, duration := flattenAlertRuleScheduledSuppression(tc.Enabled, tc.Duration)
+		if enabled != tc.ExpectedEnabled {
+			t.Fatalf("Expected enabled to be %t but got %t", tc.ExpectedEnabled, enabled)
+		}
+		if duration != tc.ExpectedDuration {
+			t.Fatalf("Expected duration to be %q but got %q", tc.ExpectedDuration, duration)
+		}
+	}
+}
+
+func TestAlertRuleScheduledSuppressionDuration(t *testing.T) {
+	validateFunc := resourceSentinelAlertRuleScheduled().Schema["suppression_duration"].ValidateFunc
+
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "PT4M59S",
+			Valid: false,
+		},
+		{
+			Input: "PT5M",
+			Valid: true,
+		},
+		{
+			Input: "PT5H",
+			Valid: true,
+		},
+		{
+			Input: "PT24H",
+			Valid: true,
+		},
+		{
+			Input: "PT24H1M",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		_, errors := validateFunc(tc.Input, "suppression_duration")
+		valid := len(errors) == 0
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, errors)
+		}
+	}
+}
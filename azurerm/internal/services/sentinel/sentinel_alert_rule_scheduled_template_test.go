@@ -0,0 +1,52 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenAlertRuleScheduledTemplateGuid(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Configured string
+		Returned   *string
+		Expected   string
+	}{
+		{
+			Name:     "no template",
+			Expected: "",
+		},
+		{
+			Name:       "template returned",
+			Configured: "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+			Returned:   utils.String("65360bb0-8986-4ade-a89d-af3cf44d28aa"),
+			Expected:   "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+		},
+		{
+			Name:       "template detached",
+			Configured: "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+			Expected:   "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+		},
+		{
+			Name:       "template detached with an empty value",
+			Configured: "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+			Returned:   utils.String(""),
+			Expected:   "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+		},
+		{
+			Name:     "imported",
+			Returned: utils.String("65360bb0-8986-4ade-a89d-af3cf44d28aa"),
+			Expected: "65360bb0-8986-4ade-a89d-af3cf44d28aa",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenAlertRuleScheduledTemplateGuid(tc.Configured, tc.Returned)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
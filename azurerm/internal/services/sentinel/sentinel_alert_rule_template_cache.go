@@ -0,0 +1,65 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+)
+
+// alertRuleTemplatesGetter is the subset of the Alert Rule Templates client used to retrieve an Alert Rule Template.
+type alertRuleTemplatesGetter interface {
+	Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, alertRuleTemplateID string) (securityinsight.AlertRuleTemplateModel, error)
+}
+
+type alertRuleTemplateCacheEntry struct {
+	lock     sync.Mutex
+	template securityinsight.BasicAlertRuleTemplate
+}
+
+// alertRuleTemplateCache caches the Alert Rule Templates referenced by Alert Rules, keyed by workspace and template name.
+//
+// Alert Rule Templates are built-in to Sentinel and don't change during a Terraform run - since the provider process
+// only lives for the duration of a run, the cache is implicitly empty at the start of each one.
+type alertRuleTemplateCache struct {
+	lock    sync.Mutex
+	entries map[string]*alertRuleTemplateCacheEntry
+}
+
+var alertRuleTemplatesCache = &alertRuleTemplateCache{
+	entries: make(map[string]*alertRuleTemplateCacheEntry),
+}
+
+func (c *alertRuleTemplateCache) get(ctx context.Context, client alertRuleTemplatesGetter, workspaceID *loganalyticsParse.LogAnalyticsWorkspaceId, name string) (securityinsight.BasicAlertRuleTemplate, error) {
+	entry := c.entry(workspaceID, name)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.template == nil {
+		template, err := getAlertRuleTemplateByName(ctx, client, workspaceID, name)
+		if err != nil {
+			return nil, err
+		}
+		entry.template = template
+	}
+
+	return entry.template, nil
+}
+
+func (c *alertRuleTemplateCache) entry(workspaceID *loganalyticsParse.LogAnalyticsWorkspaceId, name string) *alertRuleTemplateCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := strings.ToLower(fmt.Sprintf("%s/%s", workspaceID.ID(), name))
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &alertRuleTemplateCacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
@@ -0,0 +1,85 @@
+package sentinel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type fakeAlertRuleTemplatesGetter struct {
+	getCalls int
+}
+
+func (f *fakeAlertRuleTemplatesGetter) Get(_ context.Context, _ string, _ string, _ string, alertRuleTemplateID string) (securityinsight.AlertRuleTemplateModel, error) {
+	f.getCalls++
+	return securityinsight.AlertRuleTemplateModel{
+		Value: securityinsight.ScheduledAlertRuleTemplate{
+			Name: utils.String(alertRuleTemplateID),
+		},
+	}, nil
+}
+
+func TestAlertRuleTemplateCache(t *testing.T) {
+	workspaceID := loganalyticsParse.NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	otherWorkspaceID := loganalyticsParse.NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace2")
+	client := &fakeAlertRuleTemplatesGetter{}
+	cache := &alertRuleTemplateCache{entries: make(map[string]*alertRuleTemplateCacheEntry)}
+	ctx := context.TODO()
+
+	for i := 0; i < 5; i++ {
+		template, err := cache.get(ctx, client, &workspaceID, "65360bb0-8986-4ade-a89d-af3cf44d28aa")
+		if err != nil {
+			t.Fatalf("retrieving template: %+v", err)
+		}
+		if _, ok := template.(securityinsight.ScheduledAlertRuleTemplate); !ok {
+			t.Fatalf("expected a Scheduled Alert Rule Template but got %T", template)
+		}
+	}
+	if client.getCalls != 1 {
+		t.Fatalf("expected 1 get call, got %d", client.getCalls)
+	}
+
+	if _, err := cache.get(ctx, client, &otherWorkspaceID, "65360bb0-8986-4ade-a89d-af3cf44d28aa"); err != nil {
+		t.Fatalf("retrieving template: %+v", err)
+	}
+	if client.getCalls != 2 {
+		t.Fatalf("expected the template to be retrieved again for another workspace, got %d get calls", client.getCalls)
+	}
+}
+
+func benchmarkAlertRuleTemplateResolution(b *testing.B, cached bool) {
+	const ruleCount = 20
+	workspaceID := loganalyticsParse.NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	client := &fakeAlertRuleTemplatesGetter{}
+	ctx := context.TODO()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		// each iteration simulates a run in which every rule references the same template
+		cache := &alertRuleTemplateCache{entries: make(map[string]*alertRuleTemplateCacheEntry)}
+		for i := 0; i < ruleCount; i++ {
+			var err error
+			if cached {
+				_, err = cache.get(ctx, client, &workspaceID, "65360bb0-8986-4ade-a89d-af3cf44d28aa")
+			} else {
+				_, err = getAlertRuleTemplateByName(ctx, client, &workspaceID, "65360bb0-8986-4ade-a89d-af3cf44d28aa")
+			}
+			if err != nil {
+				b.Fatalf("retrieving template: %+v", err)
+			}
+		}
+	}
+
+	b.ReportMetric(float64(client.getCalls)/float64(b.N), "api-calls/run")
+}
+
+func BenchmarkAlertRuleTemplateResolution_withoutCache(b *testing.B) {
+	benchmarkAlertRuleTemplateResolution(b, false)
+}
+
+func BenchmarkAlertRuleTemplateResolution_withCache(b *testing.B) {
+	benchmarkAlertRuleTemplateResolution(b, true)
+}
@@ -157,7 +157,7 @@ func dataSourceSentinelAlertRuleTemplateRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func getAlertRuleTemplateByName(ctx context.Context, client *securityinsight.AlertRuleTemplatesClient, workspaceID *loganalyticsParse.LogAnalyticsWorkspaceId, name string) (res securityinsight.BasicAlertRuleTemplate, err error) {
+func getAlertRuleTemplateByName(ctx context.Context, client alertRuleTemplatesGetter, workspaceID *loganalyticsParse.LogAnalyticsWorkspaceId, name string) (res securityinsight.BasicAlertRuleTemplate, err error) {
 	template, err := client.Get(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, name)
 	if err != nil {
 		return nil, err
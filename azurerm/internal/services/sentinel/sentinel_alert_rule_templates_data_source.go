@@ -0,0 +1,224 @@
+package sentinel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceSentinelAlertRuleTemplates() *schema.Resource {
+	tactics := make([]string, 0)
+	for _, tactic := range securityinsight.PossibleAttackTacticValues() {
+		tactics = append(tactics, string(tactic))
+	}
+
+	return &schema.Resource{
+		Read: dataSourceSentinelAlertRuleTemplatesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.KindBasicAlertRuleTemplateKindFusion),
+					string(securityinsight.KindBasicAlertRuleTemplateKindMLBehaviorAnalytics),
+					string(securityinsight.KindBasicAlertRuleTemplateKindMicrosoftSecurityIncidentCreation),
+					string(securityinsight.KindBasicAlertRuleTemplateKindScheduled),
+				}, false),
+			},
+
+			"tactics": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(tactics, false),
+				},
+			},
+
+			"alert_rule_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tactics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentinelAlertRuleTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	kind := d.Get("kind").(string)
+	tactics := make([]string, 0)
+	for _, tactic := range d.Get("tactics").(*schema.Set).List() {
+		tactics = append(tactics, tactic.(string))
+	}
+
+	it, err := client.ListComplete(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName)
+	if err != nil {
+		return fmt.Errorf("listing Sentinel Alert Rule Templates in %s: %+v", workspaceID, err)
+	}
+
+	templates := make([]interface{}, 0)
+	for it.NotDone() {
+		if template := summariseAlertRuleTemplate(it.Value()); template != nil && template.matches(kind, tactics) {
+			id := parse.NewSentinelAlertRuleTemplateID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, template.name)
+
+			templates = append(templates, map[string]interface{}{
+				"id":           id.ID(),
+				"name":         template.name,
+				"display_name": template.displayName,
+				"kind":         template.kind,
+				"tactics":      flattenAlertRuleScheduledTactics(&template.tactics),
+			})
+		}
+
+		if err := it.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Sentinel Alert Rule Templates in %s: %+v", workspaceID, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("log_analytics_workspace_id", workspaceID.ID())
+
+	if err := d.Set("alert_rule_templates", templates); err != nil {
+		return fmt.Errorf("setting `alert_rule_templates`: %+v", err)
+	}
+
+	return nil
+}
+
+type alertRuleTemplateSummary struct {
+	name        string
+	displayName string
+	kind        string
+	tactics     []securityinsight.AttackTactic
+}
+
+// summariseAlertRuleTemplate returns the fields common to all kinds of Alert Rule Template, or nil when the kind isn't supported.
+func summariseAlertRuleTemplate(input securityinsight.BasicAlertRuleTemplate) *alertRuleTemplateSummary {
+	var name, displayName *string
+	var tactics *[]securityinsight.AttackTactic
+	var kind securityinsight.KindBasicAlertRuleTemplate
+
+	switch template := input.(type) {
+	case securityinsight.FusionAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if prop := template.FusionAlertRuleTemplateProperties; prop != nil {
+			displayName, tactics = prop.DisplayName, prop.Tactics
+		}
+	case securityinsight.MLBehaviorAnalyticsAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if prop := template.MLBehaviorAnalyticsAlertRuleTemplateProperties; prop != nil {
+			displayName, tactics = prop.DisplayName, prop.Tactics
+		}
+	case securityinsight.MicrosoftSecurityIncidentCreationAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if prop := template.MicrosoftSecurityIncidentCreationAlertRuleTemplateProperties; prop != nil {
+			displayName = prop.DisplayName
+		}
+	case securityinsight.ScheduledAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if prop := template.ScheduledAlertRuleTemplateProperties; prop != nil {
+			displayName, tactics = prop.DisplayName, prop.Tactics
+		}
+	default:
+		return nil
+	}
+
+	if name == nil {
+		return nil
+	}
+
+	output := alertRuleTemplateSummary{
+		name: *name,
+		kind: string(kind),
+	}
+	if displayName != nil {
+		output.displayName = *displayName
+	}
+	if tactics != nil {
+		output.tactics = *tactics
+	}
+
+	return &output
+}
+
+// matches returns whether the template is of the specified kind and covers all of the specified tactics - an empty kind or list of tactics matches everything.
+func (t alertRuleTemplateSummary) matches(kind string, tactics []string) bool {
+	if kind != "" && kind != t.kind {
+		return false
+	}
+
+	for _, tactic := range tactics {
+		found := false
+		for _, existing := range t.tactics {
+			if string(existing) == tactic {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
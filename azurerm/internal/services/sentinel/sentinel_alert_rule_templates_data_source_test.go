@@ -0,0 +1,67 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type SentinelAlertRuleTemplatesDataSource struct{}
+
+func TestAccSentinelAlertRuleTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_templates", "test")
+	r := SentinelAlertRuleTemplatesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("alert_rule_templates.#").Exists(),
+				check.That(data.ResourceName).Key("alert_rule_templates.0.id").Exists(),
+				check.That(data.ResourceName).Key("alert_rule_templates.0.name").Exists(),
+				check.That(data.ResourceName).Key("alert_rule_templates.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("alert_rule_templates.0.kind").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleTemplatesDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_templates", "test")
+	r := SentinelAlertRuleTemplatesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("alert_rule_templates.0.kind").HasValue("Scheduled"),
+				check.That(data.ResourceName).Key("alert_rule_templates.0.tactics.#").Exists(),
+			),
+		},
+	})
+}
+
+func (r SentinelAlertRuleTemplatesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_templates" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+`, SentinelAlertRuleScheduledResource{}.template(data))
+}
+
+func (r SentinelAlertRuleTemplatesDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_templates" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+  kind                       = "Scheduled"
+  tactics                    = ["Persistence"]
+}
+`, SentinelAlertRuleScheduledResource{}.template(data))
+}
@@ -0,0 +1,57 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+func TestAlertRuleTemplateSummaryMatches(t *testing.T) {
+	template := alertRuleTemplateSummary{
+		name: "template1",
+		kind: string(securityinsight.KindBasicAlertRuleTemplateKindScheduled),
+		tactics: []securityinsight.AttackTactic{
+			securityinsight.Persistence,
+			securityinsight.PrivilegeEscalation,
+		},
+	}
+
+	cases := []struct {
+		Kind    string
+		Tactics []string
+		Matches bool
+	}{
+		{
+			Matches: true,
+		},
+		{
+			Kind:    "Scheduled",
+			Matches: true,
+		},
+		{
+			Kind:    "Fusion",
+			Matches: false,
+		},
+		{
+			Tactics: []string{"Persistence"},
+			Matches: true,
+		},
+		{
+			Kind:    "Scheduled",
+			Tactics: []string{"Persistence", "PrivilegeEscalation"},
+			Matches: true,
+		},
+		{
+			Tactics: []string{"Persistence", "Impact"},
+			Matches: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing kind %q and tactics %v", tc.Kind, tc.Tactics)
+
+		if matches := template.matches(tc.Kind, tc.Tactics); matches != tc.Matches {
+			t.Fatalf("Expected %t but got %t", tc.Matches, matches)
+		}
+	}
+}
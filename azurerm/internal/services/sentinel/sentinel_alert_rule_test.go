@@ -0,0 +1,91 @@
+package sentinel
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+)
+
+func TestValidateSentinelAlertRuleImportId(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// alert rule id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/alertRules/rule1",
+			Valid: true,
+		},
+		{
+			// workspace id without a display name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+			Valid: false,
+		},
+		{
+			// workspace id and display name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1|Some Rule",
+			Valid: true,
+		},
+		{
+			// display name containing the separator
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1|Some | Rule",
+			Valid: true,
+		},
+		{
+			// empty display name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1|",
+			Valid: false,
+		},
+		{
+			// invalid workspace id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1|Some Rule",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		err := validateSentinelAlertRuleImportId(tc.Input)
+		valid := err == nil
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestDescribeAlertRule(t *testing.T) {
+	id := parse.NewAlertRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "a1b2c3d4-0000-0000-0000-000000000000")
+
+	cases := []struct {
+		DisplayName string
+		Expected    []string
+	}{
+		{
+			DisplayName: "",
+			Expected:    []string{`Name "a1b2c3d4-0000-0000-0000-000000000000"`},
+		},
+		{
+			DisplayName: "Suspicious Sign In",
+			Expected:    []string{`"Suspicious Sign In"`, `Name "a1b2c3d4-0000-0000-0000-000000000000"`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.DisplayName)
+
+		err := fmt.Errorf("creating Sentinel Alert Rule Scheduled %s: %+v", describeAlertRule(id, tc.DisplayName), "boom")
+		for _, expected := range tc.Expected {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("Expected %q to contain %q", err.Error(), expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package sentinel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceSentinelAutomationRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSentinelAutomationRuleRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"order": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"triggers_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"triggers_when": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"condition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"action_incident": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"classification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"classification_comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"action_playbook": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"logic_app_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentinelAutomationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AutomationRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewAutomationRuleID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if prop := resp.AutomationRuleProperties; prop != nil {
+		d.Set("display_name", prop.DisplayName)
+
+		var order int
+		if prop.Order != nil {
+			order = int(*prop.Order)
+		}
+		d.Set("order", order)
+
+		if tl := prop.TriggeringLogic; tl != nil {
+			enabled := false
+			if tl.IsEnabled != nil {
+				enabled = *tl.IsEnabled
+			}
+			d.Set("enabled", enabled)
+
+			expiration := ""
+			if tl.ExpirationTimeUtc != nil {
+				expiration = tl.ExpirationTimeUtc.Format(time.RFC3339)
+			}
+			d.Set("expiration", expiration)
+
+			d.Set("triggers_on", tl.TriggersOn)
+			d.Set("triggers_when", tl.TriggersWhen)
+
+			if err := d.Set("condition", flattenAutomationRuleConditions(tl.Conditions)); err != nil {
+				return fmt.Errorf("setting `condition`: %+v", err)
+			}
+		}
+
+		actionIncident, actionPlaybook := flattenAutomationRuleActions(prop.Actions)
+		if err := d.Set("action_incident", actionIncident); err != nil {
+			return fmt.Errorf("setting `action_incident`: %+v", err)
+		}
+		if err := d.Set("action_playbook", actionPlaybook); err != nil {
+			return fmt.Errorf("setting `action_playbook`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenAutomationRuleConditions(input *[]securityinsight.BasicAutomationRuleCondition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, condition := range *input {
+		// only property conditions are currently supported by the API
+		condition, ok := condition.(securityinsight.AutomationRulePropertyValuesCondition)
+		if !ok || condition.ConditionProperties == nil {
+			continue
+		}
+		prop := condition.ConditionProperties
+
+		output = append(output, map[string]interface{}{
+			"property": string(prop.PropertyName),
+			"operator": string(prop.Operator),
+			"values":   utils.FlattenStringSlice(prop.PropertyValues),
+		})
+	}
+
+	return output
+}
+
+func flattenAutomationRuleActions(input *[]securityinsight.BasicAutomationRuleAction) ([]interface{}, []interface{}) {
+	actionIncident := make([]interface{}, 0)
+	actionPlaybook := make([]interface{}, 0)
+	if input == nil {
+		return actionIncident, actionPlaybook
+	}
+
+	for _, action := range *input {
+		switch action := action.(type) {
+		case securityinsight.AutomationRuleModifyPropertiesAction:
+			var order int
+			if action.Order != nil {
+				order = int(*action.Order)
+			}
+
+			var status, classification, classificationComment, ownerId, severity string
+			labels := make([]interface{}, 0)
+			if config := action.ActionConfiguration; config != nil {
+				status = string(config.Status)
+				classification = string(config.Classification)
+				if config.ClassificationComment != nil {
+					classificationComment = *config.ClassificationComment
+				}
+				if config.Owner != nil && config.Owner.ObjectID != nil {
+					ownerId = config.Owner.ObjectID.String()
+				}
+				severity = string(config.Severity)
+				labels = flattenSentinelIncidentLabels(config.Labels)
+			}
+
+			actionIncident = append(actionIncident, map[string]interface{}{
+				"order":                  order,
+				"status":                 status,
+				"classification":         classification,
+				"classification_comment": classificationComment,
+				"labels":                 labels,
+				"owner_id":               ownerId,
+				"severity":               severity,
+			})
+		case securityinsight.AutomationRuleRunPlaybookAction:
+			var order int
+			if action.Order != nil {
+				order = int(*action.Order)
+			}
+
+			var logicAppId, tenantId string
+			if config := action.ActionConfiguration; config != nil {
+				if config.LogicAppResourceID != nil {
+					logicAppId = *config.LogicAppResourceID
+				}
+				if config.TenantID != nil {
+					tenantId = *config.TenantID
+				}
+			}
+
+			actionPlaybook = append(actionPlaybook, map[string]interface{}{
+				"order":        order,
+				"logic_app_id": logicAppId,
+				"tenant_id":    tenantId,
+			})
+		}
+	}
+
+	return actionIncident, actionPlaybook
+}
@@ -0,0 +1,128 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type SentinelAutomationRuleDataSource struct {
+}
+
+func TestAccSentinelAutomationRuleDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_automation_rule", "test")
+	r := SentinelAutomationRuleDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue("acctest-SentinelAutomationRule"),
+				check.That(data.ResourceName).Key("order").HasValue("1"),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("triggers_on").HasValue("Incidents"),
+				check.That(data.ResourceName).Key("triggers_when").HasValue("Created"),
+				check.That(data.ResourceName).Key("condition.#").HasValue("1"),
+				check.That(data.ResourceName).Key("condition.0.property").HasValue("IncidentTitle"),
+				check.That(data.ResourceName).Key("condition.0.operator").HasValue("Contains"),
+				check.That(data.ResourceName).Key("condition.0.values.0").HasValue("acctest"),
+				check.That(data.ResourceName).Key("action_incident.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action_incident.0.order").HasValue("1"),
+				check.That(data.ResourceName).Key("action_incident.0.severity").HasValue("High"),
+				check.That(data.ResourceName).Key("action_playbook.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (r SentinelAutomationRuleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_automation_rule" "test" {
+  name                       = jsondecode(azurerm_resource_group_template_deployment.test.output_content).automationRuleName.value
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+`, r.template(data))
+}
+
+// template provisions an Automation Rule via an ARM Template, since there is no Terraform resource to manage one.
+func (SentinelAutomationRuleDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-automationrule-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    workspaceName = {
+      value = azurerm_log_analytics_solution.test.workspace_name
+    }
+  })
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "workspaceName": {
+      "type": "string"
+    }
+  },
+  "variables": {
+    "automationRuleName": "[guid(resourceGroup().id, parameters('workspaceName'))]"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.OperationalInsights/workspaces/providers/automationRules",
+      "apiVersion": "2019-01-01-preview",
+      "name": "[concat(parameters('workspaceName'), '/Microsoft.SecurityInsights/', variables('automationRuleName'))]",
+      "properties": {
+        "displayName": "acctest-SentinelAutomationRule",
+        "order": 1,
+        "triggeringLogic": {
+          "isEnabled": true,
+          "triggersOn": "Incidents",
+          "triggersWhen": "Created",
+          "conditions": [
+            {
+              "conditionType": "Property",
+              "conditionProperties": {
+                "propertyName": "IncidentTitle",
+                "operator": "Contains",
+                "propertyValues": [
+                  "acctest"
+                ]
+              }
+            }
+          ]
+        },
+        "actions": [
+          {
+            "order": 1,
+            "actionType": "ModifyProperties",
+            "actionConfiguration": {
+              "severity": "High"
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "automationRuleName": {
+      "type": "string",
+      "value": "[variables('automationRuleName')]"
+    }
+  }
+}
+TEMPLATE
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), data.RandomInteger)
+}
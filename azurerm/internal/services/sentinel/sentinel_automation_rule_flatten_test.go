@@ -0,0 +1,51 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenAutomationRuleActions(t *testing.T) {
+	actions := []securityinsight.BasicAutomationRuleAction{
+		securityinsight.AutomationRuleModifyPropertiesAction{
+			Order: utils.Int32(1),
+			ActionConfiguration: &securityinsight.AutomationRuleModifyPropertiesActionActionConfiguration{
+				Severity: securityinsight.IncidentSeverityHigh,
+				Labels: &[]securityinsight.IncidentLabel{
+					{LabelName: utils.String("label1")},
+				},
+			},
+		},
+		securityinsight.AutomationRuleRunPlaybookAction{
+			Order: utils.Int32(2),
+			ActionConfiguration: &securityinsight.AutomationRuleRunPlaybookActionActionConfiguration{
+				LogicAppResourceID: utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/workflows/workflow1"),
+				TenantID:           utils.String("00000000-0000-0000-0000-000000000000"),
+			},
+		},
+	}
+
+	actionIncident, actionPlaybook := flattenAutomationRuleActions(&actions)
+	if len(actionIncident) != 1 {
+		t.Fatalf("Expected 1 incident action but got %d", len(actionIncident))
+	}
+	incident := actionIncident[0].(map[string]interface{})
+	if incident["order"].(int) != 1 || incident["severity"].(string) != "High" || len(incident["labels"].([]interface{})) != 1 {
+		t.Fatalf("Unexpected incident action: %+v", incident)
+	}
+
+	if len(actionPlaybook) != 1 {
+		t.Fatalf("Expected 1 playbook action but got %d", len(actionPlaybook))
+	}
+	playbook := actionPlaybook[0].(map[string]interface{})
+	if playbook["order"].(int) != 2 || playbook["tenant_id"].(string) != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Unexpected playbook action: %+v", playbook)
+	}
+
+	actionIncident, actionPlaybook = flattenAutomationRuleActions(nil)
+	if len(actionIncident) != 0 || len(actionPlaybook) != 0 {
+		t.Fatalf("Expected no actions but got %d incident and %d playbook actions", len(actionIncident), len(actionPlaybook))
+	}
+}
@@ -0,0 +1,125 @@
+package sentinel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceSentinelAutomationRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSentinelAutomationRulesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"automation_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentinelAutomationRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AutomationRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	it, err := client.ListComplete(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName)
+	if err != nil {
+		return fmt.Errorf("listing Sentinel Automation Rules in %s: %+v", workspaceID, err)
+	}
+
+	rules := make([]interface{}, 0)
+	for it.NotDone() {
+		rule := it.Value()
+		if rule.Name != nil {
+			id := parse.NewAutomationRuleID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, *rule.Name)
+
+			displayName := ""
+			order := 0
+			enabled := false
+			if prop := rule.AutomationRuleProperties; prop != nil {
+				if prop.DisplayName != nil {
+					displayName = *prop.DisplayName
+				}
+				if prop.Order != nil {
+					order = int(*prop.Order)
+				}
+				if prop.TriggeringLogic != nil && prop.TriggeringLogic.IsEnabled != nil {
+					enabled = *prop.TriggeringLogic.IsEnabled
+				}
+			}
+
+			rules = append(rules, map[string]interface{}{
+				"id":           id.ID(),
+				"name":         id.Name,
+				"display_name": displayName,
+				"order":        order,
+				"enabled":      enabled,
+			})
+		}
+
+		if err := it.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Sentinel Automation Rules in %s: %+v", workspaceID, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("log_analytics_workspace_id", workspaceID.ID())
+
+	if err := d.Set("automation_rules", rules); err != nil {
+		return fmt.Errorf("setting `automation_rules`: %+v", err)
+	}
+
+	return nil
+}
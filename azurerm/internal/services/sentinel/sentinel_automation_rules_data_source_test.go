@@ -0,0 +1,67 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type SentinelAutomationRulesDataSource struct {
+}
+
+func TestAccSentinelAutomationRulesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_automation_rules", "test")
+	r := SentinelAutomationRulesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("automation_rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("automation_rules.0.id").Exists(),
+				check.That(data.ResourceName).Key("automation_rules.0.display_name").HasValue("acctest-SentinelAutomationRule"),
+				check.That(data.ResourceName).Key("automation_rules.0.order").HasValue("1"),
+				check.That(data.ResourceName).Key("automation_rules.0.enabled").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccSentinelAutomationRulesDataSource_empty(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_automation_rules", "test")
+	r := SentinelAutomationRulesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.empty(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("automation_rules.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (SentinelAutomationRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_automation_rules" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+
+  depends_on = [azurerm_resource_group_template_deployment.test]
+}
+`, SentinelAutomationRuleDataSource{}.template(data))
+}
+
+func (SentinelAutomationRulesDataSource) empty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_automation_rules" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+`, SentinelAlertRuleScheduledResource{}.template(data))
+}
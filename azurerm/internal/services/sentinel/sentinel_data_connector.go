@@ -52,6 +52,14 @@ func assertDataConnectorKind(dc securityinsight.BasicDataConnector, expectKind s
 		kind = securityinsight.DataConnectorKindAmazonWebServicesCloudTrail
 	case securityinsight.MDATPDataConnector:
 		kind = securityinsight.DataConnectorKindMicrosoftDefenderAdvancedThreatProtection
+	case securityinsight.Dynamics365DataConnector:
+		kind = securityinsight.DataConnectorKindDynamics365
+	case securityinsight.MSTIDataConnector:
+		kind = securityinsight.DataConnectorKindMicrosoftThreatIntelligence
+	case securityinsight.MTPDataConnector:
+		kind = securityinsight.DataConnectorKindMicrosoftThreatProtection
+	case securityinsight.TiTaxiiDataConnector:
+		kind = securityinsight.DataConnectorKindThreatIntelligenceTaxii
 	}
 	if expectKind != kind {
 		return fmt.Errorf("Sentinel Data Connector has mismatched kind, expected: %q, got %q", expectKind, kind)
@@ -0,0 +1,137 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SentinelDataConnectorAwsCloudTrailResource struct{}
+
+func TestAccAzureRMSentinelDataConnectorAwsCloudTrail_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_aws_cloud_trail", "test")
+	r := SentinelDataConnectorAwsCloudTrailResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "role1"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMSentinelDataConnectorAwsCloudTrail_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_aws_cloud_trail", "test")
+	r := SentinelDataConnectorAwsCloudTrailResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "role1"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aws_role_arn").HasValue("arn:aws:iam::000000000000:role/role1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the role ARN is updated in-place
+			Config: r.basic(data, "role2"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aws_role_arn").HasValue("arn:aws:iam::000000000000:role/role2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMSentinelDataConnectorAwsCloudTrail_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_aws_cloud_trail", "test")
+	r := SentinelDataConnectorAwsCloudTrailResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "role1"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SentinelDataConnectorAwsCloudTrailResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.DataConnectorsClient
+
+	id, err := parse.DataConnectorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, sentinel.OperationalInsightsResourceProvider, id.WorkspaceName, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SentinelDataConnectorAwsCloudTrailResource) basic(data acceptance.TestData, roleName string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_aws_cloud_trail" "test" {
+  name                       = "accTestDC-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  aws_role_arn               = "arn:aws:iam::000000000000:role/%s"
+}
+`, template, data.RandomInteger, roleName)
+}
+
+func (r SentinelDataConnectorAwsCloudTrailResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data, "role1")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_aws_cloud_trail" "import" {
+  name                       = azurerm_sentinel_data_connector_aws_cloud_trail.test.name
+  log_analytics_workspace_id = azurerm_sentinel_data_connector_aws_cloud_trail.test.log_analytics_workspace_id
+  aws_role_arn               = azurerm_sentinel_data_connector_aws_cloud_trail.test.aws_role_arn
+}
+`, template)
+}
+
+func (r SentinelDataConnectorAwsCloudTrailResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -3,6 +3,7 @@ package sentinel
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
@@ -57,6 +58,13 @@ func resourceSentinelDataConnectorAzureSecurityCenter() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+
+			"alerts_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -91,13 +99,18 @@ func resourceSentinelDataConnectorAzureSecurityCenterCreate(d *schema.ResourceDa
 		subscriptionId = id.SubscriptionId
 	}
 
+	alertsState := securityinsight.Enabled
+	if !d.Get("alerts_enabled").(bool) {
+		alertsState = securityinsight.Disabled
+	}
+
 	param := securityinsight.ASCDataConnector{
 		Name: &name,
 		ASCDataConnectorProperties: &securityinsight.ASCDataConnectorProperties{
 			SubscriptionID: &subscriptionId,
 			DataTypes: &securityinsight.AlertsDataTypeOfDataConnector{
 				Alerts: &securityinsight.AlertsDataTypeOfDataConnectorAlerts{
-					State: securityinsight.Enabled,
+					State: alertsState,
 				},
 			},
 		},
@@ -135,14 +148,15 @@ func resourceSentinelDataConnectorAzureSecurityCenterRead(d *schema.ResourceData
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	dc, ok := resp.Value.(securityinsight.ASCDataConnector)
-	if !ok {
-		return fmt.Errorf("%s was not an Azure Security Center Data Connector", id)
+	if err := assertDataConnectorKind(resp.Value, securityinsight.DataConnectorKindAzureSecurityCenter); err != nil {
+		return fmt.Errorf("asserting %s: %+v", id, err)
 	}
+	dc := resp.Value.(securityinsight.ASCDataConnector)
 
 	d.Set("name", id.Name)
 	d.Set("log_analytics_workspace_id", workspaceId.ID())
 	d.Set("subscription_id", dc.SubscriptionID)
+	d.Set("alerts_enabled", flattenDataConnectorAzureSecurityCenterDataTypes(dc.DataTypes))
 
 	return nil
 }
@@ -163,3 +177,11 @@ func resourceSentinelDataConnectorAzureSecurityCenterDelete(d *schema.ResourceDa
 
 	return nil
 }
+
+func flattenDataConnectorAzureSecurityCenterDataTypes(input *securityinsight.AlertsDataTypeOfDataConnector) bool {
+	if input == nil || input.Alerts == nil {
+		return false
+	}
+
+	return strings.EqualFold(string(input.Alerts.State), string(securityinsight.Enabled))
+}
@@ -49,6 +49,38 @@ func TestAccAzureRMSentinelDataConnectorAzureSecurityCenter_complete(t *testing.
 	})
 }
 
+func TestAccAzureRMSentinelDataConnectorAzureSecurityCenter_alertsEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_azure_security_center", "test")
+	r := SentinelDataConnectorAzureSecurityCenterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.alertsEnabled(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alerts_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMSentinelDataConnectorAzureSecurityCenter_alertsDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_azure_security_center", "test")
+	r := SentinelDataConnectorAzureSecurityCenterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.alertsEnabled(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alerts_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMSentinelDataConnectorAzureSecurityCenter_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_azure_security_center", "test")
 	r := SentinelDataConnectorAzureSecurityCenterResource{}
@@ -109,6 +141,19 @@ resource "azurerm_sentinel_data_connector_azure_security_center" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SentinelDataConnectorAzureSecurityCenterResource) alertsEnabled(data acceptance.TestData, enabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_azure_security_center" "test" {
+  name                       = "accTestDC-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  alerts_enabled             = %t
+}
+`, template, data.RandomInteger, enabled)
+}
+
 func (r SentinelDataConnectorAzureSecurityCenterResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -92,6 +92,23 @@ func TestAccAzureRMSentinelDataConnectorMicrosoftCloudAppSecurity_update(t *test
 	})
 }
 
+func TestAccAzureRMSentinelDataConnectorMicrosoftCloudAppSecurity_alertsOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_microsoft_cloud_app_security", "test")
+	r := SentinelDataConnectorMicrosoftCloudAppSecurityResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data, true, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alerts_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("discovery_logs_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMSentinelDataConnectorMicrosoftCloudAppSecurity_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_microsoft_cloud_app_security", "test")
 	r := SentinelDataConnectorMicrosoftCloudAppSecurityResource{}
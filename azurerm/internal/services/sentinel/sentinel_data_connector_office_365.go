@@ -203,34 +203,20 @@ func resourceSentinelDataConnectorOffice365Read(d *schema.ResourceData, meta int
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	dc, ok := resp.Value.(securityinsight.OfficeDataConnector)
-	if !ok {
-		return fmt.Errorf("%s was not an Office 365 Data Connector", id)
+	if err := assertDataConnectorKind(resp.Value, securityinsight.DataConnectorKindOffice365); err != nil {
+		return fmt.Errorf("asserting %s: %+v", id, err)
 	}
+	dc := resp.Value.(securityinsight.OfficeDataConnector)
 
 	d.Set("name", id.Name)
 	d.Set("log_analytics_workspace_id", workspaceId.ID())
 	d.Set("tenant_id", dc.TenantID)
 
-	if dt := dc.DataTypes; dt != nil {
-		exchangeEnabled := false
-		if exchange := dt.Exchange; exchange != nil {
-			exchangeEnabled = strings.EqualFold(string(exchange.State), string(securityinsight.Enabled))
-		}
-		d.Set("exchange_enabled", exchangeEnabled)
-
-		sharePointEnabled := false
-		if sharePoint := dt.SharePoint; sharePoint != nil {
-			sharePointEnabled = strings.EqualFold(string(sharePoint.State), string(securityinsight.Enabled))
-		}
-		d.Set("sharepoint_enabled", sharePointEnabled)
-
-		teamsEnabled := false
-		if teams := dt.Teams; teams != nil {
-			teamsEnabled = strings.EqualFold(string(teams.State), string(securityinsight.Enabled))
-		}
-		d.Set("teams_enabled", teamsEnabled)
-	}
+	// the API omits data types which are disabled, rather than returning them as `Disabled`
+	exchangeEnabled, sharePointEnabled, teamsEnabled := flattenDataConnectorOffice365DataTypes(dc.DataTypes)
+	d.Set("exchange_enabled", exchangeEnabled)
+	d.Set("sharepoint_enabled", sharePointEnabled)
+	d.Set("teams_enabled", teamsEnabled)
 
 	return nil
 }
@@ -251,3 +237,23 @@ func resourceSentinelDataConnectorOffice365Delete(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+func flattenDataConnectorOffice365DataTypes(input *securityinsight.OfficeDataConnectorDataTypes) (exchangeEnabled, sharePointEnabled, teamsEnabled bool) {
+	if input == nil {
+		return false, false, false
+	}
+
+	if exchange := input.Exchange; exchange != nil {
+		exchangeEnabled = strings.EqualFold(string(exchange.State), string(securityinsight.Enabled))
+	}
+
+	if sharePoint := input.SharePoint; sharePoint != nil {
+		sharePointEnabled = strings.EqualFold(string(sharePoint.State), string(securityinsight.Enabled))
+	}
+
+	if teams := input.Teams; teams != nil {
+		teamsEnabled = strings.EqualFold(string(teams.State), string(securityinsight.Enabled))
+	}
+
+	return exchangeEnabled, sharePointEnabled, teamsEnabled
+}
@@ -0,0 +1,160 @@
+package sentinel
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+)
+
+func TestAssertDataConnectorKind(t *testing.T) {
+	cases := []struct {
+		DataConnector securityinsight.BasicDataConnector
+		ExpectKind    securityinsight.DataConnectorKind
+		Valid         bool
+	}{
+		{
+			DataConnector: securityinsight.AADDataConnector{},
+			ExpectKind:    securityinsight.DataConnectorKindAzureActiveDirectory,
+			Valid:         true,
+		},
+		{
+			DataConnector: securityinsight.ASCDataConnector{},
+			ExpectKind:    securityinsight.DataConnectorKindAzureActiveDirectory,
+			Valid:         false,
+		},
+		{
+			DataConnector: securityinsight.TiTaxiiDataConnector{},
+			ExpectKind:    securityinsight.DataConnectorKindThreatIntelligence,
+			Valid:         false,
+		},
+		{
+			// e.g. the API returning an unexpected kind
+			DataConnector: nil,
+			ExpectKind:    securityinsight.DataConnectorKindOffice365,
+			Valid:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %T (expected kind: %q)", tc.DataConnector, tc.ExpectKind)
+
+		err := assertDataConnectorKind(tc.DataConnector, tc.ExpectKind)
+		valid := err == nil
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestFlattenDataConnectorOffice365DataTypes(t *testing.T) {
+	cases := []struct {
+		Name              string
+		Input             *securityinsight.OfficeDataConnectorDataTypes
+		ExchangeEnabled   bool
+		SharePointEnabled bool
+		TeamsEnabled      bool
+	}{
+		{
+			Name:  "no data types",
+			Input: nil,
+		},
+		{
+			Name: "SharePoint omitted",
+			Input: &securityinsight.OfficeDataConnectorDataTypes{
+				Exchange: &securityinsight.OfficeDataConnectorDataTypesExchange{
+					State: securityinsight.Enabled,
+				},
+				Teams: &securityinsight.OfficeDataConnectorDataTypesTeams{
+					State: securityinsight.Enabled,
+				},
+			},
+			ExchangeEnabled: true,
+			TeamsEnabled:    true,
+		},
+		{
+			Name: "SharePoint disabled",
+			Input: &securityinsight.OfficeDataConnectorDataTypes{
+				Exchange: &securityinsight.OfficeDataConnectorDataTypesExchange{
+					State: securityinsight.Enabled,
+				},
+				SharePoint: &securityinsight.OfficeDataConnectorDataTypesSharePoint{
+					State: securityinsight.Disabled,
+				},
+				Teams: &securityinsight.OfficeDataConnectorDataTypesTeams{
+					State: securityinsight.Enabled,
+				},
+			},
+			ExchangeEnabled: true,
+			TeamsEnabled:    true,
+		},
+		{
+			Name: "all enabled",
+			Input: &securityinsight.OfficeDataConnectorDataTypes{
+				Exchange: &securityinsight.OfficeDataConnectorDataTypesExchange{
+					State: securityinsight.Enabled,
+				},
+				SharePoint: &securityinsight.OfficeDataConnectorDataTypesSharePoint{
+					State: securityinsight.Enabled,
+				},
+				Teams: &securityinsight.OfficeDataConnectorDataTypesTeams{
+					State: securityinsight.Enabled,
+				},
+			},
+			ExchangeEnabled:   true,
+			SharePointEnabled: true,
+			TeamsEnabled:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		exchangeEnabled, sharePointEnabled, teamsEnabled := flattenDataConnectorOffice365DataTypes(tc.Input)
+		if exchangeEnabled != tc.ExchangeEnabled || sharePointEnabled != tc.SharePointEnabled || teamsEnabled != tc.TeamsEnabled {
+			t.Fatalf("Expected exchange/sharepoint/teams to be %t/%t/%t but got %t/%t/%t", tc.ExchangeEnabled, tc.SharePointEnabled, tc.TeamsEnabled, exchangeEnabled, sharePointEnabled, teamsEnabled)
+		}
+	}
+}
+
+func TestFlattenDataConnectorAzureSecurityCenterDataTypes(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Input         *securityinsight.AlertsDataTypeOfDataConnector
+		AlertsEnabled bool
+	}{
+		{
+			Name:  "no data types",
+			Input: nil,
+		},
+		{
+			Name:  "alerts omitted",
+			Input: &securityinsight.AlertsDataTypeOfDataConnector{},
+		},
+		{
+			Name: "alerts disabled",
+			Input: &securityinsight.AlertsDataTypeOfDataConnector{
+				Alerts: &securityinsight.AlertsDataTypeOfDataConnectorAlerts{
+					State: securityinsight.Disabled,
+				},
+			},
+		},
+		{
+			Name: "alerts enabled",
+			Input: &securityinsight.AlertsDataTypeOfDataConnector{
+				Alerts: &securityinsight.AlertsDataTypeOfDataConnectorAlerts{
+					State: securityinsight.Enabled,
+				},
+			},
+			AlertsEnabled: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		alertsEnabled := flattenDataConnectorAzureSecurityCenterDataTypes(tc.Input)
+		if alertsEnabled != tc.AlertsEnabled {
+			t.Fatalf("Expected alerts to be %t but got %t", tc.AlertsEnabled, alertsEnabled)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,6 +15,7 @@ import (
 	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -57,6 +59,15 @@ func resourceSentinelDataConnectorThreatIntelligence() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+
+			"lookback_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
 		},
 	}
 }
@@ -104,6 +115,16 @@ func resourceSentinelDataConnectorThreatIntelligenceCreateUpdate(d *schema.Resou
 		Kind: securityinsight.KindBasicDataConnectorKindThreatIntelligence,
 	}
 
+	if v, ok := d.GetOk("lookback_date"); ok {
+		lookbackDate, err := date.ParseTime(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("`lookback_date` wasn't a valid RFC3339 date %q: %+v", v.(string), err)
+		}
+		param.TIDataConnectorProperties.TipLookbackPeriod = &date.Time{
+			Time: lookbackDate,
+		}
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, param); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -135,15 +156,21 @@ func resourceSentinelDataConnectorThreatIntelligenceRead(d *schema.ResourceData,
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	dc, ok := resp.Value.(securityinsight.TIDataConnector)
-	if !ok {
-		return fmt.Errorf("%s was not a Threat Intelligence Data Connector", id)
+	if err := assertDataConnectorKind(resp.Value, securityinsight.DataConnectorKindThreatIntelligence); err != nil {
+		return fmt.Errorf("asserting %s: %+v", id, err)
 	}
+	dc := resp.Value.(securityinsight.TIDataConnector)
 
 	d.Set("name", id.Name)
 	d.Set("log_analytics_workspace_id", workspaceId.ID())
 	d.Set("tenant_id", dc.TenantID)
 
+	lookbackDate := ""
+	if dc.TipLookbackPeriod != nil {
+		lookbackDate = dc.TipLookbackPeriod.Format(time.RFC3339)
+	}
+	d.Set("lookback_date", lookbackDate)
+
 	return nil
 }
 
@@ -49,6 +49,51 @@ func TestAccAzureRMSentinelDataConnectorThreatIntelligence_complete(t *testing.T
 	})
 }
 
+func TestAccAzureRMSentinelDataConnectorThreatIntelligence_updateLookbackDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_threat_intelligence", "test")
+	r := SentinelDataConnectorThreatIntelligenceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.lookbackDate(data, "2021-01-01T00:00:00Z"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lookback_date").HasValue("2021-01-01T00:00:00Z"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// changing the lookback date forces a new resource to be created
+			Config: r.lookbackDate(data, "2021-02-01T00:00:00Z"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lookback_date").HasValue("2021-02-01T00:00:00Z"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMSentinelDataConnectorThreatIntelligence_lookbackDateWithOffset(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_threat_intelligence", "test")
+	r := SentinelDataConnectorThreatIntelligenceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.lookbackDate(data, "2021-01-01T01:00:00+01:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lookback_date").HasValue("2021-01-01T00:00:00Z"),
+			),
+		},
+		{
+			// the same instant in UTC shouldn't force a new resource to be created
+			Config:   r.lookbackDate(data, "2021-01-01T00:00:00Z"),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccAzureRMSentinelDataConnectorThreatIntelligence_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_data_connector_threat_intelligence", "test")
 	r := SentinelDataConnectorThreatIntelligenceResource{}
@@ -109,6 +154,19 @@ resource "azurerm_sentinel_data_connector_threat_intelligence" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SentinelDataConnectorThreatIntelligenceResource) lookbackDate(data acceptance.TestData, lookbackDate string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_data_connector_threat_intelligence" "test" {
+  name                       = "accTestDC-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  lookback_date              = "%s"
+}
+`, template, data.RandomInteger, lookbackDate)
+}
+
 func (r SentinelDataConnectorThreatIntelligenceResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
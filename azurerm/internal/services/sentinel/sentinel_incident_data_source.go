@@ -0,0 +1,182 @@
+package sentinel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceSentinelIncident() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSentinelIncidentRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"classification": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"owner": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assigned_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"user_principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"labels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentinelIncidentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.IncidentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	workspaceID, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewIncidentID(workspaceID.SubscriptionId, workspaceID.ResourceGroup, workspaceID.WorkspaceName, name)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if prop := resp.IncidentProperties; prop != nil {
+		d.Set("title", prop.Title)
+		d.Set("severity", string(prop.Severity))
+		d.Set("status", string(prop.Status))
+		d.Set("classification", string(prop.Classification))
+
+		if err := d.Set("owner", flattenSentinelIncidentOwner(prop.Owner)); err != nil {
+			return fmt.Errorf("setting `owner`: %+v", err)
+		}
+
+		if err := d.Set("labels", flattenSentinelIncidentLabels(prop.Labels)); err != nil {
+			return fmt.Errorf("setting `labels`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenSentinelIncidentOwner(input *securityinsight.IncidentOwnerInfo) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var assignedTo, email, objectId, userPrincipalName string
+	if input.AssignedTo != nil {
+		assignedTo = *input.AssignedTo
+	}
+	if input.Email != nil {
+		email = *input.Email
+	}
+	if input.ObjectID != nil {
+		objectId = input.ObjectID.String()
+	}
+	if input.UserPrincipalName != nil {
+		userPrincipalName = *input.UserPrincipalName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"assigned_to":         assignedTo,
+			"email":               email,
+			"object_id":           objectId,
+			"user_principal_name": userPrincipalName,
+		},
+	}
+}
+
+func flattenSentinelIncidentLabels(input *[]securityinsight.IncidentLabel) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, label := range *input {
+		if label.LabelName == nil {
+			continue
+		}
+		output = append(output, *label.LabelName)
+	}
+
+	return output
+}
@@ -0,0 +1,124 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type SentinelIncidentDataSource struct {
+}
+
+func TestAccSentinelIncidentDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_incident", "test")
+	r := SentinelIncidentDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("title").HasValue("acctest-SentinelIncident"),
+				check.That(data.ResourceName).Key("severity").HasValue("Medium"),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+				check.That(data.ResourceName).Key("labels.#").HasValue("1"),
+				check.That(data.ResourceName).Key("labels.0").HasValue("acctest"),
+			),
+		},
+	})
+}
+
+func TestAccSentinelIncidentDataSource_notFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_incident", "test")
+	r := SentinelIncidentDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config:      r.notFound(data),
+			ExpectError: regexp.MustCompile("was not found"),
+		},
+	})
+}
+
+func (r SentinelIncidentDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_incident" "test" {
+  name                       = jsondecode(azurerm_resource_group_template_deployment.test.output_content).incidentName.value
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+`, r.template(data))
+}
+
+func (r SentinelIncidentDataSource) notFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_incident" "test" {
+  name                       = "00000000-0000-0000-0000-000000000000"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.test.workspace_resource_id
+}
+`, SentinelAlertRuleScheduledResource{}.template(data))
+}
+
+// template provisions an Incident via an ARM Template, since there is no Terraform resource to manage one.
+func (SentinelIncidentDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-incident-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    workspaceName = {
+      value = azurerm_log_analytics_solution.test.workspace_name
+    }
+  })
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "workspaceName": {
+      "type": "string"
+    }
+  },
+  "variables": {
+    "incidentName": "[guid(resourceGroup().id, parameters('workspaceName'))]"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.OperationalInsights/workspaces/providers/incidents",
+      "apiVersion": "2019-01-01-preview",
+      "name": "[concat(parameters('workspaceName'), '/Microsoft.SecurityInsights/', variables('incidentName'))]",
+      "properties": {
+        "title": "acctest-SentinelIncident",
+        "severity": "Medium",
+        "status": "Active",
+        "labels": [
+          {
+            "labelName": "acctest"
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "incidentName": {
+      "type": "string",
+      "value": "[variables('incidentName')]"
+    }
+  }
+}
+TEMPLATE
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,165 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceSentinelIncidentRelation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSentinelIncidentRelationCreate,
+		Read:   resourceSentinelIncidentRelationRead,
+		Delete: resourceSentinelIncidentRelationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IncidentRelationID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"incident_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IncidentID,
+			},
+
+			"related_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"related_resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"related_resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSentinelIncidentRelationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.IncidentRelationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	incidentId, err := parse.IncidentID(d.Get("incident_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewIncidentRelationID(incidentId.SubscriptionId, incidentId.ResourceGroup, incidentId.WorkspaceName, incidentId.Name, d.Get("name").(string))
+
+	resp, err := client.GetRelation(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IncidentName, id.RelationName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(resp.Response) {
+		return tf.ImportAsExistsError("azurerm_sentinel_incident_relation", id.ID())
+	}
+
+	param := securityinsight.Relation{
+		RelationProperties: &securityinsight.RelationProperties{
+			RelatedResourceID: utils.String(d.Get("related_resource_id").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateRelation(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IncidentName, id.RelationName, param); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSentinelIncidentRelationRead(d, meta)
+}
+
+func resourceSentinelIncidentRelationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.IncidentRelationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IncidentRelationID(d.Id())
+	if err != nil {
+		return err
+	}
+	incidentId := parse.NewIncidentID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.IncidentName)
+
+	resp, err := client.GetRelation(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IncidentName, id.RelationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.RelationName)
+	d.Set("incident_id", incidentId.ID())
+
+	if prop := resp.RelationProperties; prop != nil {
+		d.Set("related_resource_id", prop.RelatedResourceID)
+		d.Set("related_resource_name", prop.RelatedResourceName)
+		d.Set("related_resource_type", prop.RelatedResourceType)
+	}
+
+	return nil
+}
+
+func resourceSentinelIncidentRelationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.IncidentRelationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IncidentRelationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteRelation(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.IncidentName, id.RelationName)
+	if err != nil {
+		// the relation is removed along with the Incident or the related Bookmark/Alert, so it may already be gone
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
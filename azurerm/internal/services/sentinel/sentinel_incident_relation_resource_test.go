@@ -0,0 +1,159 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SentinelIncidentRelationResource struct{}
+
+func TestAccSentinelIncidentRelation_bookmark(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_incident_relation", "test")
+	r := SentinelIncidentRelationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.bookmark(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("related_resource_id").Exists(),
+				check.That(data.ResourceName).Key("related_resource_type").HasValue("Microsoft.SecurityInsights/Bookmarks"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelIncidentRelation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_incident_relation", "test")
+	r := SentinelIncidentRelationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.bookmark(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SentinelIncidentRelationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.IncidentRelationsClient
+
+	id, err := parse.IncidentRelationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetRelation(ctx, id.ResourceGroup, sentinel.OperationalInsightsResourceProvider, id.WorkspaceName, id.IncidentName, id.RelationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SentinelIncidentRelationResource) bookmark(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_incident_relation" "test" {
+  name                = "4d3b5a1f-0b8e-4a2c-9f57-2c1d6e8b3a90"
+  incident_id         = jsondecode(azurerm_resource_group_template_deployment.test.output_content).incidentId.value
+  related_resource_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).bookmarkId.value
+}
+`, r.template(data))
+}
+
+func (r SentinelIncidentRelationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_incident_relation" "import" {
+  name                = azurerm_sentinel_incident_relation.test.name
+  incident_id         = azurerm_sentinel_incident_relation.test.incident_id
+  related_resource_id = azurerm_sentinel_incident_relation.test.related_resource_id
+}
+`, r.bookmark(data))
+}
+
+// template provisions an Incident and a Bookmark via an ARM Template, since there are no Terraform resources to manage them.
+func (SentinelIncidentRelationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-incidentrelation-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    workspaceName = {
+      value = azurerm_log_analytics_solution.test.workspace_name
+    }
+  })
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "workspaceName": {
+      "type": "string"
+    }
+  },
+  "variables": {
+    "incidentName": "[guid(resourceGroup().id, parameters('workspaceName'), 'incident')]",
+    "bookmarkName": "[guid(resourceGroup().id, parameters('workspaceName'), 'bookmark')]"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.OperationalInsights/workspaces/providers/incidents",
+      "apiVersion": "2019-01-01-preview",
+      "name": "[concat(parameters('workspaceName'), '/Microsoft.SecurityInsights/', variables('incidentName'))]",
+      "properties": {
+        "title": "acctest-SentinelIncident",
+        "severity": "Low",
+        "status": "New"
+      }
+    },
+    {
+      "type": "Microsoft.OperationalInsights/workspaces/providers/bookmarks",
+      "apiVersion": "2019-01-01-preview",
+      "name": "[concat(parameters('workspaceName'), '/Microsoft.SecurityInsights/', variables('bookmarkName'))]",
+      "properties": {
+        "displayName": "acctest-SentinelBookmark",
+        "query": "SecurityEvent | take 1"
+      }
+    }
+  ],
+  "outputs": {
+    "incidentId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.OperationalInsights/workspaces/providers/incidents', parameters('workspaceName'), 'Microsoft.SecurityInsights', variables('incidentName'))]"
+    },
+    "bookmarkId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.OperationalInsights/workspaces/providers/bookmarks', parameters('workspaceName'), 'Microsoft.SecurityInsights', variables('bookmarkName'))]"
+    }
+  }
+}
+TEMPLATE
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), data.RandomInteger)
+}
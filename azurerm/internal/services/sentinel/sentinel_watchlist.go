@@ -0,0 +1,32 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type watchlistsGetter interface {
+	Get(ctx context.Context, resourceGroupName string, operationalInsightsResourceProvider string, workspaceName string, watchlistAlias string) (securityinsight.Watchlist, error)
+}
+
+// findMissingSentinelWatchlistAliases returns those of the specified aliases which don't match a Watchlist within the Workspace.
+func findMissingSentinelWatchlistAliases(ctx context.Context, client watchlistsGetter, workspaceID loganalyticsParse.LogAnalyticsWorkspaceId, aliases []string) ([]string, error) {
+	missing := make([]string, 0)
+	for _, alias := range aliases {
+		resp, err := client.Get(ctx, workspaceID.ResourceGroup, OperationalInsightsResourceProvider, workspaceID.WorkspaceName, alias)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				missing = append(missing, alias)
+				continue
+			}
+
+			return nil, fmt.Errorf("retrieving Sentinel Watchlist %q in %s: %+v", alias, workspaceID, err)
+		}
+	}
+
+	return missing, nil
+}
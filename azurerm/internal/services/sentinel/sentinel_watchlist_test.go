@@ -0,0 +1,84 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+)
+
+type fakeWatchlistsGetter struct {
+	aliases map[string]int
+}
+
+func (f fakeWatchlistsGetter) Get(_ context.Context, _ string, _ string, _ string, watchlistAlias string) (securityinsight.Watchlist, error) {
+	statusCode, ok := f.aliases[watchlistAlias]
+	if !ok {
+		statusCode = http.StatusNotFound
+	}
+
+	result := securityinsight.Watchlist{
+		Response: autorest.Response{Response: &http.Response{StatusCode: statusCode}},
+	}
+	if statusCode != http.StatusOK {
+		return result, fmt.Errorf("unexpected status %d", statusCode)
+	}
+	return result, nil
+}
+
+func TestFindMissingSentinelWatchlistAliases(t *testing.T) {
+	workspaceID := loganalyticsParse.NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	client := fakeWatchlistsGetter{
+		aliases: map[string]int{
+			"existing":  http.StatusOK,
+			"forbidden": http.StatusForbidden,
+		},
+	}
+
+	cases := []struct {
+		Aliases     []string
+		Missing     []string
+		ExpectError bool
+	}{
+		{
+			Aliases: []string{},
+			Missing: []string{},
+		},
+		{
+			Aliases: []string{"existing"},
+			Missing: []string{},
+		},
+		{
+			Aliases: []string{"existing", "missing", "other"},
+			Missing: []string{"missing", "other"},
+		},
+		{
+			Aliases:     []string{"forbidden"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %v", tc.Aliases)
+
+		missing, err := findMissingSentinelWatchlistAliases(context.TODO(), client, workspaceID, tc.Aliases)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(tc.Missing, missing) {
+			t.Fatalf("Expected %v but got %v", tc.Missing, missing)
+		}
+	}
+}